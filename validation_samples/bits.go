@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// BitwiseAnd returns a & b
+func BitwiseAnd(a, b int) int {
+	return a & b
+}
+
+// BitwiseOr returns a | b
+func BitwiseOr(a, b int) int {
+	return a | b
+}
+
+// BitwiseXor returns a ^ b
+func BitwiseXor(a, b int) int {
+	return a ^ b
+}
+
+// LeftShift returns a << n. Bits shifted past the top of an int are lost,
+// as with Go's << operator.
+func LeftShift(a, n int) (int, error) {
+	if n < 0 {
+		return 0, fmt.Errorf("shift count must be non-negative: %w", ErrNegative)
+	}
+	return a << n, nil
+}
+
+// RightShift returns a >> n, an arithmetic shift that keeps the sign of
+// negative numbers
+func RightShift(a, n int) (int, error) {
+	if n < 0 {
+		return 0, fmt.Errorf("shift count must be non-negative: %w", ErrNegative)
+	}
+	return a >> n, nil
+}
+
+// PopCount returns the number of 1 bits in n's two's-complement
+// representation, so PopCount(-1) is the width of an int
+func PopCount(n int) int {
+	return bits.OnesCount(uint(n))
+}
+
+// IsPowerOfTwo reports whether n is a positive power of two
+func IsPowerOfTwo(n int) bool {
+	return n > 0 && n&(n-1) == 0
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+	"testing"
+)
+
+func TestBitwiseOperations(t *testing.T) {
+	tests := []struct {
+		name         string
+		a, b         int
+		and, or, xor int
+	}{
+		{name: "disjoint_bits", a: 0b1010, b: 0b0101, and: 0, or: 0b1111, xor: 0b1111},
+		{name: "overlapping_bits", a: 0b1100, b: 0b1010, and: 0b1000, or: 0b1110, xor: 0b0110},
+		{name: "with_zero", a: 0xff, b: 0, and: 0, or: 0xff, xor: 0xff},
+		{name: "same_value", a: 42, b: 42, and: 42, or: 42, xor: 0},
+		{name: "negative_one", a: -1, b: 0x0f, and: 0x0f, or: -1, xor: -16},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BitwiseAnd(tt.a, tt.b); got != tt.and {
+				t.Errorf("BitwiseAnd(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.and)
+			}
+			if got := BitwiseOr(tt.a, tt.b); got != tt.or {
+				t.Errorf("BitwiseOr(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.or)
+			}
+			if got := BitwiseXor(tt.a, tt.b); got != tt.xor {
+				t.Errorf("BitwiseXor(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.xor)
+			}
+		})
+	}
+}
+
+func TestShifts(t *testing.T) {
+	tests := []struct {
+		name     string
+		shift    func(a, n int) (int, error)
+		a, n     int
+		expected int
+		wantErr  bool
+	}{
+		{name: "left_by_one", shift: LeftShift, a: 1, n: 1, expected: 2},
+		{name: "left_by_four", shift: LeftShift, a: 3, n: 4, expected: 48},
+		{name: "left_by_zero", shift: LeftShift, a: 7, n: 0, expected: 7},
+		{name: "left_negative_value", shift: LeftShift, a: -1, n: 3, expected: -8},
+		{name: "left_past_width", shift: LeftShift, a: 1, n: 64, expected: 0},
+		{name: "left_negative_count", shift: LeftShift, a: 1, n: -1, wantErr: true},
+		{name: "right_by_one", shift: RightShift, a: 8, n: 1, expected: 4},
+		{name: "right_truncates", shift: RightShift, a: 7, n: 1, expected: 3},
+		{name: "right_keeps_sign", shift: RightShift, a: -8, n: 2, expected: -2},
+		{name: "right_past_width", shift: RightShift, a: -5, n: 100, expected: -1},
+		{name: "right_negative_count", shift: RightShift, a: 8, n: -2, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.shift(tt.a, tt.n)
+			if tt.wantErr {
+				if !errors.Is(err, ErrNegative) {
+					t.Errorf("shift(%d, %d) = %d, error = %v, want %v", tt.a, tt.n, result, err, ErrNegative)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("shift(%d, %d) unexpected error: %v", tt.a, tt.n, err)
+			}
+			if result != tt.expected {
+				t.Errorf("shift(%d, %d) = %d, want %d", tt.a, tt.n, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPopCount(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected int
+	}{
+		{name: "zero", n: 0, expected: 0},
+		{name: "one", n: 1, expected: 1},
+		{name: "seven", n: 7, expected: 3},
+		{name: "power_of_two", n: 1024, expected: 1},
+		{name: "alternating", n: 0b10101010, expected: 4},
+		{name: "max_int", n: math.MaxInt, expected: bits.UintSize - 1},
+		{name: "negative_one", n: -1, expected: bits.UintSize},
+		{name: "min_int", n: math.MinInt, expected: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PopCount(tt.n); got != tt.expected {
+				t.Errorf("PopCount(%d) = %d, want %d", tt.n, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsPowerOfTwo(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected bool
+	}{
+		{name: "zero", n: 0, expected: false},
+		{name: "one", n: 1, expected: true},
+		{name: "two", n: 2, expected: true},
+		{name: "eight", n: 8, expected: true},
+		{name: "six", n: 6, expected: false},
+		{name: "largest_power", n: 1 << 62, expected: true},
+		{name: "max_int", n: math.MaxInt, expected: false},
+		{name: "negative_eight", n: -8, expected: false},
+		{name: "min_int", n: math.MinInt, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPowerOfTwo(tt.n); got != tt.expected {
+				t.Errorf("IsPowerOfTwo(%d) = %v, want %v", tt.n, got, tt.expected)
+			}
+		})
+	}
+}
@@ -2,63 +2,623 @@
 package main
 
 import (
+	"cmp"
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math"
-	"regexp"
-	"strings"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
 )
 
-// Calculator represents a calculator with history
+// Calculator represents a calculator with history. It is safe for concurrent
+// use; read Result through CurrentResult when sharing a Calculator.
 type Calculator struct {
+	sync.Mutex
+
 	Result  float64
 	History []string
+	// MaxHistory caps the number of retained history entries; 0 means unlimited
+	MaxHistory int
+	// AngleMode controls whether trigonometric inputs are radians or degrees
+	AngleMode AngleMode
+	// Precision is the number of decimal places shown in history entries and
+	// by FormatResult
+	Precision int
+	// HistoryFormatter, when set, formats the history entries of operations
+	// with two operands. op is "+", "-", "*", "/", "^" or "%" for the binary
+	// operators, with a and b in order; "% of" for Percentage, with a the
+	// percent and b the value; "->" for PercentChange, from a to b; and "log"
+	// for LogBase, with a the argument and b the base. Single-operand
+	// operations keep their built-in format. When nil, entries look like
+	// "10.00 + 5.00 = 15.00".
+	HistoryFormatter func(op string, a, b, result float64) string
+
+	// entries mirrors History with a timestamp for each entry
+	entries []HistoryEntry
+	// resultStack holds the Result in effect before each history entry
+	resultStack []float64
+	// redoStack holds operations reverted by Undo, most recent last
+	redoStack []undoneOp
+	// memory is the M+/M-/MR/MC register
+	memory float64
+	// accCount and accSum track the values fed to Accumulate
+	accCount int
+	accSum   float64
+	// fibCache memoizes Fibonacci results by n
+	fibCache map[int]int
+	// historyWriter receives each new history entry, if set
+	historyWriter io.Writer
+}
+
+// AngleMode selects the unit used for trigonometric inputs
+type AngleMode int
+
+const (
+	// Radians interprets angles in radians (the default)
+	Radians AngleMode = iota
+	// Degrees interprets angles in degrees
+	Degrees
+)
+
+// defaultPrecision is the number of decimal places NewCalculator displays
+const defaultPrecision = 2
+
+// tanAsymptoteEpsilon is how close cos(x) may get to zero before Tan treats
+// x as lying on an asymptote
+const tanAsymptoteEpsilon = 1e-12
+
+// undoneOp is an operation reverted by Undo that Redo can re-apply
+type undoneOp struct {
+	entry  string
+	result float64
 }
 
 // NewCalculator creates a new calculator instance
 func NewCalculator() *Calculator {
 	return &Calculator{
-		Result:  0,
-		History: make([]string, 0),
+		Result:    0,
+		History:   make([]string, 0),
+		Precision: defaultPrecision,
 	}
 }
 
 // Add performs addition and returns the result
 func (c *Calculator) Add(a, b float64) (float64, error) {
+	c.Lock()
+	defer c.Unlock()
+
 	if math.IsNaN(a) || math.IsNaN(b) {
-		return 0, errors.New("NaN values not allowed")
+		return 0, ErrNaN
 	}
 	
 	if math.IsInf(a, 0) || math.IsInf(b, 0) {
-		return 0, errors.New("infinite values not allowed")
+		return 0, ErrInfinite
 	}
 	
 	result := a + b
-	c.History = append(c.History, fmt.Sprintf("%.2f + %.2f = %.2f", a, b, result))
-	c.Result = result
+	if math.IsInf(result, 0) { // Finite operands can still overflow
+		return 0, ErrOverflow
+	}
+
+	c.recordBinary("+", a, b, result)
+	return result, nil
+}
+
+// precisionLossTolerance is the share of the smaller operand AddChecked lets
+// rounding discard before it reports precision loss
+const precisionLossTolerance = 1e-9
+
+// AddChecked adds a and b like Add, additionally reporting whether the sum
+// lost significant precision. The exact rounding error of a + b is
+// recovered with the TwoSum algorithm; precision counts as lost when that
+// error exceeds precisionLossTolerance times the smaller operand's
+// magnitude, as when 1 is added to 1e16 and absorbed entirely. Ordinary
+// rounding such as 0.1 + 0.2 is not reported. Cancellation of nearly equal
+// values is exact in floating point and is not reported either.
+func (c *Calculator) AddChecked(a, b float64) (float64, bool, error) {
+	result, err := c.Add(a, b)
+	if err != nil {
+		return 0, false, err
+	}
+
+	// TwoSum: a + b == result + roundoff exactly
+	bVirtual := result - a
+	aVirtual := result - bVirtual
+	roundoff := (a - aVirtual) + (b - bVirtual)
+
+	smaller := math.Min(math.Abs(a), math.Abs(b))
+	return result, math.Abs(roundoff) > smaller*precisionLossTolerance, nil
+}
+
+// Sum adds any number of values and returns the total
+func (c *Calculator) Sum(values ...float64) (float64, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	total := 0.0
+	for _, v := range values {
+		if math.IsNaN(v) {
+			return 0, ErrNaN
+		}
+
+		if math.IsInf(v, 0) {
+			return 0, ErrInfinite
+		}
+
+		total += v
+		if math.IsInf(total, 0) {
+			return 0, ErrOverflow
+		}
+	}
+
+	c.record(fmt.Sprintf("sum(%d values) = %s", len(values), c.formatNumber(total)), total)
+	return total, nil
+}
+
+// Subtract performs subtraction and returns the result
+func (c *Calculator) Subtract(a, b float64) (float64, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return 0, ErrNaN
+	}
+
+	if math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return 0, ErrInfinite
+	}
+
+	result := a - b
+	if math.IsInf(result, 0) { // Finite operands can still overflow
+		return 0, ErrOverflow
+	}
+
+	c.recordBinary("-", a, b, result)
+	return result, nil
+}
+
+// Multiply performs multiplication and returns the result
+func (c *Calculator) Multiply(a, b float64) (float64, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return 0, ErrNaN
+	}
+
+	if math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return 0, ErrInfinite
+	}
+
+	result := a * b
+	if math.IsInf(result, 0) { // Finite operands can still overflow
+		return 0, ErrOverflow
+	}
+
+	c.recordBinary("*", a, b, result)
+	return result, nil
+}
+
+// Power raises base to the exponent exp and returns the result
+func (c *Calculator) Power(base, exp float64) (float64, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if math.IsNaN(base) || math.IsNaN(exp) {
+		return 0, ErrNaN
+	}
+
+	if math.IsInf(base, 0) || math.IsInf(exp, 0) {
+		return 0, ErrInfinite
+	}
+
+	if base == 0 && exp < 0 { // 0^-n is 1/0
+		return 0, ErrDivByZero
+	}
+
+	result := math.Pow(base, exp)
+	if math.IsNaN(result) { // Negative base with a fractional exponent
+		return 0, errors.New("result is not a real number")
+	}
+
+	if math.IsInf(result, 0) {
+		return 0, ErrOverflow
+	}
+
+	c.recordBinary("^", base, exp, result)
+	return result, nil
+}
+
+// SquareRoot calculates the square root of x and returns the result
+func (c *Calculator) SquareRoot(x float64) (float64, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if math.IsNaN(x) {
+		return 0, ErrNaN
+	}
+
+	if math.IsInf(x, 0) {
+		return 0, ErrInfinite
+	}
+
+	if x < 0 {
+		return 0, errors.New("cannot take square root of negative number")
+	}
+
+	result := math.Sqrt(x)
+	c.record(fmt.Sprintf("sqrt(%s) = %s", c.formatNumber(x), c.formatNumber(result)), result)
+	return result, nil
+}
+
+// Ln calculates the natural logarithm of x
+func (c *Calculator) Ln(x float64) (float64, error) {
+	return c.logWith("ln", math.Log, x)
+}
+
+// Log10 calculates the base-10 logarithm of x
+func (c *Calculator) Log10(x float64) (float64, error) {
+	return c.logWith("log10", math.Log10, x)
+}
+
+// LogBase calculates the logarithm of x in the given base
+func (c *Calculator) LogBase(x, base float64) (float64, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if err := checkLogArg(x); err != nil {
+		return 0, err
+	}
+
+	if math.IsNaN(base) {
+		return 0, ErrNaN
+	}
+
+	if math.IsInf(base, 0) {
+		return 0, ErrInfinite
+	}
+
+	if base <= 0 || base == 1 {
+		return 0, errors.New("logarithm base must be positive and not equal to 1")
+	}
+
+	result := math.Log(x) / math.Log(base)
+	c.recordWith("log", x, base, result, fmt.Sprintf("log(%s, base %s) = %s", c.formatNumber(x), c.formatNumber(base), c.formatNumber(result)))
+	return result, nil
+}
+
+// logWith applies the logarithm fn to x and records the result under the
+// given operation name
+func (c *Calculator) logWith(name string, fn func(float64) float64, x float64) (float64, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if err := checkLogArg(x); err != nil {
+		return 0, err
+	}
+
+	result := fn(x)
+	c.record(fmt.Sprintf("%s(%s) = %s", name, c.formatNumber(x), c.formatNumber(result)), result)
+	return result, nil
+}
+
+// checkLogArg validates that x is in the domain of a logarithm
+func checkLogArg(x float64) error {
+	if math.IsNaN(x) {
+		return ErrNaN
+	}
+
+	if math.IsInf(x, 0) {
+		return ErrInfinite
+	}
+
+	if x <= 0 {
+		return errors.New("logarithm of non-positive number")
+	}
+	return nil
+}
+
+// SetAngleMode sets how Sin, Cos and Tan interpret their input
+func (c *Calculator) SetAngleMode(mode AngleMode) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.AngleMode = mode
+}
+
+// Sin calculates the sine of x in the current angle mode
+func (c *Calculator) Sin(x float64) (float64, error) {
+	return c.trigWith("sin", x, func(sin, _ float64) (float64, error) {
+		return sin, nil
+	})
+}
+
+// Cos calculates the cosine of x in the current angle mode
+func (c *Calculator) Cos(x float64) (float64, error) {
+	return c.trigWith("cos", x, func(_, cos float64) (float64, error) {
+		return cos, nil
+	})
+}
+
+// Tan calculates the tangent of x in the current angle mode, returning an
+// error at the asymptotes (such as 90 degrees) instead of a huge value
+func (c *Calculator) Tan(x float64) (float64, error) {
+	return c.trigWith("tan", x, func(sin, cos float64) (float64, error) {
+		if math.Abs(cos) < tanAsymptoteEpsilon {
+			return 0, errors.New("tangent is undefined at this angle")
+		}
+		return sin / cos, nil
+	})
+}
+
+// trigWith computes the sine and cosine of x in the current angle mode,
+// derives the result with fn, and records it under the given operation name
+func (c *Calculator) trigWith(name string, x float64, fn func(sin, cos float64) (float64, error)) (float64, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if math.IsNaN(x) {
+		return 0, ErrNaN
+	}
+
+	if math.IsInf(x, 0) {
+		return 0, ErrInfinite
+	}
+
+	result, err := fn(c.sincos(x))
+	if err != nil {
+		return 0, err
+	}
+
+	c.record(fmt.Sprintf("%s(%s) = %s", name, c.formatNumber(x), c.formatNumber(result)), result)
+	return result, nil
+}
+
+// sincos returns the sine and cosine of x in the current angle mode. In
+// degree mode, multiples of 90 degrees give exact results so that values
+// like cos(90) are 0 rather than 6e-17.
+func (c *Calculator) sincos(x float64) (sin, cos float64) {
+	if c.AngleMode != Degrees {
+		return math.Sincos(x)
+	}
+
+	reduced := math.Mod(x, 360)
+	if math.Mod(reduced, 90) == 0 {
+		switch int(reduced/90+4) % 4 {
+		case 0:
+			return 0, 1
+		case 1:
+			return 1, 0
+		case 2:
+			return 0, -1
+		default:
+			return -1, 0
+		}
+	}
+	return math.Sincos(DegreesToRadians(reduced))
+}
+
+// DegreesToRadians converts an angle in degrees to radians
+func DegreesToRadians(d float64) float64 {
+	return d * math.Pi / 180
+}
+
+// RadiansToDegrees converts an angle in radians to degrees
+func RadiansToDegrees(r float64) float64 {
+	return r * 180 / math.Pi
+}
+
+// Abs returns the absolute value of x
+func (c *Calculator) Abs(x float64) (float64, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if math.IsNaN(x) {
+		return 0, ErrNaN
+	}
+
+	if math.IsInf(x, 0) {
+		return 0, ErrInfinite
+	}
+
+	result := math.Abs(x)
+	c.record(fmt.Sprintf("abs(%s) = %s", c.formatNumber(x), c.formatNumber(result)), result)
+	return result, nil
+}
+
+// Negate returns x with its sign flipped. Zero is always returned as
+// positive zero so history never shows "-0.00".
+func (c *Calculator) Negate(x float64) (float64, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if math.IsNaN(x) {
+		return 0, ErrNaN
+	}
+
+	if math.IsInf(x, 0) {
+		return 0, ErrInfinite
+	}
+
+	result := -x
+	if result == 0 {
+		result = 0 // Normalize -0
+	}
+	c.record(fmt.Sprintf("neg(%s) = %s", c.formatNumber(x), c.formatNumber(result)), result)
 	return result, nil
 }
 
+// Round rounds x to the given number of decimal places, with halves rounded
+// away from zero. A negative places rounds to tens, hundreds, and so on.
+func (c *Calculator) Round(x float64, places int) (float64, error) {
+	return c.roundWith("round", math.Round, x, places)
+}
+
+// Floor rounds x down to the given number of decimal places
+func (c *Calculator) Floor(x float64, places int) (float64, error) {
+	return c.roundWith("floor", math.Floor, x, places)
+}
+
+// Ceil rounds x up to the given number of decimal places
+func (c *Calculator) Ceil(x float64, places int) (float64, error) {
+	return c.roundWith("ceil", math.Ceil, x, places)
+}
+
+// roundWith applies fn to x scaled by 10^places and records the result
+// under the given operation name
+func (c *Calculator) roundWith(name string, fn func(float64) float64, x float64, places int) (float64, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if math.IsNaN(x) {
+		return 0, ErrNaN
+	}
+
+	if math.IsInf(x, 0) {
+		return 0, ErrInfinite
+	}
+
+	result := roundPlaces(x, places, fn)
+	c.record(fmt.Sprintf("%s(%s, %d) = %s", name, c.formatNumber(x), places, c.formatNumber(result)), result)
+	return result, nil
+}
+
+// roundPlaces applies fn to x at the given decimal place. The scaled value
+// is first trimmed to 15 significant digits so binary representation error
+// (2.345 * 100 == 234.49999999999997) does not change which way it rounds.
+// Places beyond the float64 exponent range leave x unchanged when positive
+// and give a zero of x's sign when negative.
+func roundPlaces(x float64, places int, fn func(float64) float64) float64 {
+	if places >= 0 {
+		scale := math.Pow10(places)
+		scaled := x * scale
+		if math.IsInf(scale, 0) || math.IsInf(scaled, 0) { // Already exact at this precision
+			return x
+		}
+		return fn(trimFloat(scaled)) / scale
+	}
+
+	scale := math.Pow10(-places)
+	if math.IsInf(scale, 0) { // Every finite x is far below the rounding unit
+		return math.Copysign(0, x)
+	}
+	return fn(trimFloat(x/scale)) * scale
+}
+
+// trimFloat rounds x to 15 significant decimal digits
+func trimFloat(x float64) float64 {
+	trimmed, err := strconv.ParseFloat(strconv.FormatFloat(x, 'g', 15, 64), 64)
+	if err != nil {
+		return x
+	}
+	return trimmed
+}
+
 // Divide performs division and returns the result
 func (c *Calculator) Divide(a, b float64) (float64, error) {
+	c.Lock()
+	defer c.Unlock()
+
 	if math.IsNaN(a) || math.IsNaN(b) {
-		return 0, errors.New("NaN values not allowed")
+		return 0, ErrNaN
 	}
 	
 	if b == 0 {
-		return 0, errors.New("division by zero is not allowed")
+		return 0, ErrDivByZero
 	}
 	
 	result := a / b
-	c.History = append(c.History, fmt.Sprintf("%.2f / %.2f = %.2f", a, b, result))
-	c.Result = result
+	if math.IsInf(result, 0) && !math.IsInf(a, 0) { // e.g. 1e308 / 1e-10
+		return 0, ErrOverflow
+	}
+
+	c.recordBinary("/", a, b, result)
+	return result, nil
+}
+
+// Modulo returns the floating-point remainder of a / b. Like math.Mod, the
+// result takes the sign of the dividend a, so -7 % 3 is -1 rather than 2.
+func (c *Calculator) Modulo(a, b float64) (float64, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return 0, ErrNaN
+	}
+
+	if math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return 0, ErrInfinite
+	}
+
+	if b == 0 {
+		return 0, errors.New("modulo by zero is not allowed")
+	}
+
+	result := math.Mod(a, b)
+	c.recordBinary("%", a, b, result)
+	return result, nil
+}
+
+// Percentage returns percent percent of value
+func (c *Calculator) Percentage(value, percent float64) (float64, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if math.IsNaN(value) || math.IsNaN(percent) {
+		return 0, ErrNaN
+	}
+
+	if math.IsInf(value, 0) || math.IsInf(percent, 0) {
+		return 0, ErrInfinite
+	}
+
+	// Scale the percent first so only a result beyond float64 overflows
+	result := value * (percent / 100)
+	if math.IsInf(result, 0) {
+		return 0, ErrOverflow
+	}
+
+	c.recordWith("% of", percent, value, result, fmt.Sprintf("%s%% of %s = %s", c.formatNumber(percent), c.formatNumber(value), c.formatNumber(result)))
 	return result, nil
 }
 
-// Fibonacci calculates the nth Fibonacci number
+// PercentChange returns the percentage difference from oldValue to newValue.
+// The change is relative to the magnitude of oldValue, so a decrease is
+// always negative.
+func (c *Calculator) PercentChange(oldValue, newValue float64) (float64, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if math.IsNaN(oldValue) || math.IsNaN(newValue) {
+		return 0, ErrNaN
+	}
+
+	if math.IsInf(oldValue, 0) || math.IsInf(newValue, 0) {
+		return 0, ErrInfinite
+	}
+
+	if oldValue == 0 {
+		return 0, errors.New("percent change from zero is undefined")
+	}
+
+	result := (newValue - oldValue) / math.Abs(oldValue) * 100
+	if math.IsInf(result, 0) { // Finite operands can still overflow
+		return 0, ErrOverflow
+	}
+
+	c.recordWith("->", oldValue, newValue, result, fmt.Sprintf("%s -> %s = %s%%", c.formatNumber(oldValue), c.formatNumber(newValue), c.formatNumber(result)))
+	return result, nil
+}
+
+// Fibonacci calculates the nth Fibonacci number. Results are cached, so
+// repeated calls for the same or a smaller n are O(1).
 func (c *Calculator) Fibonacci(n int) (int, error) {
 	if n < 0 {
-		return 0, errors.New("input must be non-negative")
+		return 0, ErrNegative
 	}
 	
 	if n > 46 { // Prevent overflow for int
@@ -68,16 +628,137 @@ func (c *Calculator) Fibonacci(n int) (int, error) {
 	if n <= 1 {
 		return n, nil
 	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if v, ok := c.fibCache[n]; ok {
+		return v, nil
+	}
+
+	if c.fibCache == nil {
+		c.fibCache = make(map[int]int)
+	}
 	
 	a, b := 0, 1
 	for i := 2; i <= n; i++ {
 		a, b = b, a+b
+		c.fibCache[i] = b
 	}
 	return b, nil
 }
 
+// FibonacciBig calculates the nth Fibonacci number with arbitrary precision,
+// removing the n <= 46 limit of Fibonacci
+func (c *Calculator) FibonacciBig(n int) (*big.Int, error) {
+	return c.FibonacciBigCtx(context.Background(), n)
+}
+
+// fibCtxCheckInterval is how many FibonacciBigCtx iterations run between
+// checks for cancellation
+const fibCtxCheckInterval = 1024
+
+// FibonacciBigCtx is like FibonacciBig but stops early and returns ctx.Err()
+// once ctx is cancelled or its deadline passes
+func (c *Calculator) FibonacciBigCtx(ctx context.Context, n int) (*big.Int, error) {
+	if n < 0 {
+		return nil, ErrNegative
+	}
+
+	a, b := big.NewInt(0), big.NewInt(1)
+	for i := 0; i < n; i++ {
+		if i%fibCtxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		a.Add(a, b)
+		a, b = b, a
+	}
+	return a, nil
+}
+
+// CurrentResult returns the result of the most recent operation
+func (c *Calculator) CurrentResult() float64 {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.Result
+}
+
+// FormatResult returns the current Result formatted with Precision decimal
+// places
+func (c *Calculator) FormatResult() string {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.formatNumber(c.Result)
+}
+
+// String summarizes the calculator as "Calculator{result: 15.00, history: 3
+// entries}", implementing fmt.Stringer
+func (c *Calculator) String() string {
+	c.Lock()
+	defer c.Unlock()
+
+	entries := "entries"
+	if len(c.History) == 1 {
+		entries = "entry"
+	}
+	return fmt.Sprintf("Calculator{result: %s, history: %d %s}", c.formatNumber(c.Result), len(c.History), entries)
+}
+
+// FormatScientific formats x in scientific notation with sigFigs significant
+// figures, such as "1.23e+04". It doesn't record history.
+func (c *Calculator) FormatScientific(x float64, sigFigs int) (string, error) {
+	if math.IsNaN(x) {
+		return "", ErrNaN
+	}
+
+	if math.IsInf(x, 0) {
+		return "", ErrInfinite
+	}
+
+	if sigFigs < 1 {
+		return "", errors.New("significant figures must be at least 1")
+	}
+	return strconv.FormatFloat(x, 'e', sigFigs-1, 64), nil
+}
+
+// ResultAsHex formats the current Result, truncated toward zero, in
+// lowercase hexadecimal without a prefix. Negative values are shown as
+// their 64-bit two's complement, so -1 is "ffffffffffffffff".
+func (c *Calculator) ResultAsHex() string {
+	return strconv.FormatUint(c.resultBits(), 16)
+}
+
+// ResultAsBinary formats the current Result like ResultAsHex, in binary
+func (c *Calculator) ResultAsBinary() string {
+	return strconv.FormatUint(c.resultBits(), 2)
+}
+
+// resultBits returns the two's complement bits of the Result truncated to an
+// int64, saturating Results beyond the int64 range
+func (c *Calculator) resultBits() uint64 {
+	c.Lock()
+	defer c.Unlock()
+
+	switch truncated := math.Trunc(c.Result); {
+	case truncated >= math.MaxInt64:
+		return math.MaxInt64
+	case truncated <= math.MinInt64:
+		return 1 << 63
+	default:
+		return uint64(int64(truncated))
+	}
+}
+
 // GetHistory returns a copy of the calculation history
 func (c *Calculator) GetHistory() []string {
+	c.Lock()
+	defer c.Unlock()
+
 	history := make([]string, len(c.History))
 	copy(history, c.History)
 	return history
@@ -85,7 +766,241 @@ func (c *Calculator) GetHistory() []string {
 
 // ClearHistory clears the calculation history
 func (c *Calculator) ClearHistory() {
+	c.Lock()
+	defer c.Unlock()
+
 	c.History = c.History[:0]
+	c.entries = c.entries[:0]
+	c.resultStack = c.resultStack[:0]
+	c.redoStack = c.redoStack[:0]
+}
+
+// Reset returns the calculator to the state NewCalculator creates: Result,
+// history, undo and redo, memory and the accumulator are cleared, and
+// MaxHistory, AngleMode, Precision and HistoryFormatter go back to their
+// defaults. Any history writer is removed.
+func (c *Calculator) Reset() {
+	c.Lock()
+	defer c.Unlock()
+
+	c.Result = 0
+	c.History = make([]string, 0)
+	c.MaxHistory = 0
+	c.AngleMode = Radians
+	c.Precision = defaultPrecision
+	c.HistoryFormatter = nil
+	c.entries = nil
+	c.resultStack = nil
+	c.redoStack = nil
+	c.memory = 0
+	c.accCount = 0
+	c.accSum = 0
+	c.fibCache = nil
+	c.historyWriter = nil
+}
+
+// SetHistoryWriter streams history to w: every entry recorded from now on is
+// also written to w followed by a newline, as the operation happens. Entries
+// already in the history are not written, and write errors are ignored so a
+// failing writer never fails a calculation. A nil w stops streaming.
+//
+// w is called while the calculator's mutex is held, so it must not call back
+// into the same Calculator (for example to log c.String()), or it will
+// deadlock.
+func (c *Calculator) SetHistoryWriter(w io.Writer) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.historyWriter = w
+}
+
+// SetMaxHistory limits history to the n most recent entries, dropping older
+// entries immediately if needed. Zero or a negative n removes the limit.
+func (c *Calculator) SetMaxHistory(n int) {
+	c.Lock()
+	defer c.Unlock()
+
+	if n < 0 {
+		n = 0
+	}
+	c.MaxHistory = n
+	c.trimHistory()
+}
+
+// SetPrecision sets the number of decimal places shown in subsequent history
+// entries and by FormatResult. Existing entries keep their formatting. A
+// negative p is treated as zero.
+func (c *Calculator) SetPrecision(p int) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.Precision = MaxOf(p, 0)
+}
+
+// MemoryAdd adds the current Result to memory (M+)
+func (c *Calculator) MemoryAdd() {
+	c.Lock()
+	defer c.Unlock()
+
+	c.memory += c.Result
+}
+
+// MemorySubtract subtracts the current Result from memory (M-)
+func (c *Calculator) MemorySubtract() {
+	c.Lock()
+	defer c.Unlock()
+
+	c.memory -= c.Result
+}
+
+// MemoryRecall returns the value stored in memory (MR)
+func (c *Calculator) MemoryRecall() float64 {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.memory
+}
+
+// MemoryClear resets memory to zero (MC)
+func (c *Calculator) MemoryClear() {
+	c.Lock()
+	defer c.Unlock()
+
+	c.memory = 0
+}
+
+// Accumulate adds v to the running accumulator used by RunningMean. NaN and
+// infinite values are ignored so they cannot poison the mean.
+func (c *Calculator) Accumulate(v float64) {
+	c.Lock()
+	defer c.Unlock()
+
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return
+	}
+	c.accCount++
+	c.accSum += v
+}
+
+// RunningMean returns the mean of the values passed to Accumulate since the
+// last ResetAccumulator, or 0 if there are none
+func (c *Calculator) RunningMean() float64 {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.accCount == 0 {
+		return 0
+	}
+	return c.accSum / float64(c.accCount)
+}
+
+// ResetAccumulator discards all accumulated values
+func (c *Calculator) ResetAccumulator() {
+	c.Lock()
+	defer c.Unlock()
+
+	c.accCount = 0
+	c.accSum = 0
+}
+
+// Undo reverts the most recent operation, dropping its history entry and
+// restoring the previous Result
+func (c *Calculator) Undo() error {
+	c.Lock()
+	defer c.Unlock()
+
+	// History can be emptied directly while undo state remains
+	if len(c.resultStack) == 0 || len(c.History) == 0 {
+		return errors.New("nothing to undo")
+	}
+
+	last := len(c.resultStack) - 1
+	entry := len(c.History) - 1
+	c.redoStack = append(c.redoStack, undoneOp{entry: c.History[entry], result: c.Result})
+	c.Result = c.resultStack[last]
+	c.resultStack = c.resultStack[:last]
+	c.History = c.History[:entry]
+	// entries can be shorter if History was modified directly
+	c.entries = c.entries[:MinOf(entry, len(c.entries))]
+	return nil
+}
+
+// Redo re-applies the operation most recently reverted by Undo. Any new
+// operation performed after an Undo discards the pending redo history.
+func (c *Calculator) Redo() error {
+	c.Lock()
+	defer c.Unlock()
+
+	if len(c.redoStack) == 0 {
+		return errors.New("nothing to redo")
+	}
+
+	last := len(c.redoStack) - 1
+	op := c.redoStack[last]
+	c.redoStack = c.redoStack[:last]
+	c.push(op.entry, op.result)
+	return nil
+}
+
+// record appends a history entry for a newly completed operation and makes
+// result the current Result. A new operation invalidates anything to redo.
+func (c *Calculator) record(entry string, result float64) {
+	c.redoStack = c.redoStack[:0]
+	c.push(entry, result)
+}
+
+// push appends a history entry and makes result the current Result,
+// remembering the prior value for Undo
+func (c *Calculator) push(entry string, result float64) {
+	c.resultStack = append(c.resultStack, c.Result)
+	c.History = append(c.History, entry)
+	c.entries = append(c.entries, HistoryEntry{Expression: entry, Timestamp: time.Now()})
+	c.Result = result
+	c.trimHistory()
+
+	if c.historyWriter != nil {
+		io.WriteString(c.historyWriter, entry+"\n")
+	}
+}
+
+// recordBinary records the result of the binary operator op applied to a
+// and b, formatted by HistoryFormatter if one is set
+func (c *Calculator) recordBinary(op string, a, b, result float64) {
+	c.recordWith(op, a, b, result, fmt.Sprintf("%s %s %s = %s", c.formatNumber(a), op, c.formatNumber(b), c.formatNumber(result)))
+}
+
+// recordWith records the result of the two-operand operation op, formatted
+// by HistoryFormatter if one is set and as entry otherwise
+func (c *Calculator) recordWith(op string, a, b, result float64, entry string) {
+	if c.HistoryFormatter != nil {
+		entry = c.HistoryFormatter(op, a, b, result)
+	}
+	c.record(entry, result)
+}
+
+// formatNumber formats x with Precision decimal places for display
+func (c *Calculator) formatNumber(x float64) string {
+	return strconv.FormatFloat(x, 'f', c.Precision, 64)
+}
+
+// trimHistory drops the oldest history entries, and their undo state, once
+// the history exceeds MaxHistory
+func (c *Calculator) trimHistory() {
+	if c.MaxHistory <= 0 {
+		return
+	}
+
+	if excess := len(c.History) - c.MaxHistory; excess > 0 {
+		c.History = append(c.History[:0], c.History[excess:]...)
+	}
+
+	if excess := len(c.entries) - c.MaxHistory; excess > 0 {
+		c.entries = append(c.entries[:0], c.entries[excess:]...)
+	}
+
+	if excess := len(c.resultStack) - c.MaxHistory; excess > 0 {
+		c.resultStack = append(c.resultStack[:0], c.resultStack[excess:]...)
+	}
 }
 
 // Standalone functions for additional testing
@@ -93,29 +1008,20 @@ func (c *Calculator) ClearHistory() {
 // CalculateArea calculates the area of a rectangle
 func CalculateArea(width, height float64) (float64, error) {
 	if width <= 0 || height <= 0 {
-		return 0, errors.New("width and height must be positive")
+		return 0, fmt.Errorf("width and height must be positive: %w", ErrNegative)
 	}
 	
 	if math.IsNaN(width) || math.IsNaN(height) {
-		return 0, errors.New("NaN values not allowed")
+		return 0, ErrNaN
 	}
 	
 	return width * height, nil
 }
 
-// ValidateEmail validates an email address format
+// ValidateEmail validates an email address format. Use ValidateEmailDetailed
+// to find out why an address was rejected.
 func ValidateEmail(email string) bool {
-	if email == "" {
-		return false
-	}
-	
-	email = strings.TrimSpace(email)
-	if len(email) < 5 { // Minimum: a@b.c
-		return false
-	}
-	
-	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
-	return emailRegex.MatchString(email)
+	return ValidateEmailDetailed(email) == nil
 }
 
 // FormatCurrency formats a number as currency
@@ -155,6 +1061,19 @@ func Max(a, b int) int {
 	return b
 }
 
+// MaxN returns the maximum of any number of integers
+func MaxN(values ...int) (int, error) {
+	if len(values) == 0 {
+		return 0, errors.New("no values provided")
+	}
+
+	largest := values[0]
+	for _, v := range values[1:] {
+		largest = Max(largest, v)
+	}
+	return largest, nil
+}
+
 // Min returns the minimum of two integers  
 func Min(a, b int) int {
 	if a < b {
@@ -163,6 +1082,130 @@ func Min(a, b int) int {
 	return b
 }
 
+// MinN returns the minimum of any number of integers
+func MinN(values ...int) (int, error) {
+	if len(values) == 0 {
+		return 0, errors.New("no values provided")
+	}
+
+	smallest := values[0]
+	for _, v := range values[1:] {
+		smallest = Min(smallest, v)
+	}
+	return smallest, nil
+}
+
+// MinMax returns both the minimum and maximum of the values in a single pass
+func MinMax(values ...int) (min, max int, err error) {
+	if len(values) == 0 {
+		return 0, 0, errors.New("no values provided")
+	}
+
+	min, max = values[0], values[0]
+	for _, v := range values[1:] {
+		if v < min {
+			min = v
+		} else if v > max {
+			max = v
+		}
+	}
+	return min, max, nil
+}
+
+// MaxOf returns the larger of two ordered values, such as ints, floats, or strings
+func MaxOf[T cmp.Ordered](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// MinOf returns the smaller of two ordered values, such as ints, floats, or strings
+func MinOf[T cmp.Ordered](a, b T) T {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// Clamp constrains value to the inclusive range [lo, hi]
+func Clamp(value, lo, hi int) (int, error) {
+	if lo > hi {
+		return 0, fmt.Errorf("invalid range: min %d is greater than max %d", lo, hi)
+	}
+	return ClampOf(value, lo, hi), nil
+}
+
+// ClampOf constrains v to the inclusive range [lo, hi] for any ordered type.
+// The caller must ensure lo <= hi; if it isn't, hi wins.
+func ClampOf[T cmp.Ordered](v, lo, hi T) T {
+	return MinOf(MaxOf(v, lo), hi)
+}
+
+// RoundToMultiple rounds value to the nearest multiple of multiple, with
+// halves rounded away from zero, so RoundToMultiple(23, 5) is 25. Like
+// Round, it trims binary representation error, so RoundToMultiple(0.37,
+// 0.05) is 0.35 rather than 0.35000000000000003.
+func RoundToMultiple(value, multiple float64) (float64, error) {
+	if math.IsNaN(value) || math.IsNaN(multiple) {
+		return 0, ErrNaN
+	}
+
+	if math.IsInf(value, 0) || math.IsInf(multiple, 0) {
+		return 0, ErrInfinite
+	}
+
+	if multiple == 0 {
+		return 0, errors.New("multiple must not be zero")
+	}
+
+	result := trimFloat(math.Round(trimFloat(value/multiple)) * multiple)
+	if math.IsInf(result, 0) {
+		return 0, ErrOverflow
+	}
+	return result, nil
+}
+
+// Lerp interpolates linearly from a to b, returning a when t is 0 and b when
+// t is 1. Values of t outside [0, 1] extrapolate beyond a and b.
+func Lerp(a, b, t float64) float64 {
+	// Weighting both ends, rather than a + t*(b-a), returns b exactly at t = 1
+	return (1-t)*a + t*b
+}
+
+// MapRange maps value from the range [inMin, inMax] onto [outMin, outMax]
+// linearly, so MapRange(5, 0, 10, 0, 100) is 50. Either range may be
+// descending, and values outside the input range extrapolate. It returns
+// an error when inMin equals inMax.
+func MapRange(value, inMin, inMax, outMin, outMax float64) (float64, error) {
+	for _, v := range []float64{value, inMin, inMax, outMin, outMax} {
+		if math.IsNaN(v) {
+			return 0, ErrNaN
+		}
+
+		if math.IsInf(v, 0) {
+			return 0, ErrInfinite
+		}
+	}
+
+	if inMin == inMax {
+		return 0, errors.New("input range must not be empty")
+	}
+
+	offset, span := value-inMin, inMax-inMin
+	if math.IsInf(offset, 0) || math.IsInf(span, 0) {
+		// Halving every term leaves the fraction unchanged and keeps both
+		// differences finite for ranges spanning most of float64
+		offset, span = value/2-inMin/2, inMax/2-inMin/2
+	}
+
+	result := Lerp(outMin, outMax, offset/span)
+	if math.IsInf(result, 0) || math.IsNaN(result) {
+		return 0, ErrOverflow
+	}
+	return result, nil
+}
+
 func main() {
 	calc := NewCalculator()
 	result, _ := calc.Add(10, 5)
@@ -0,0 +1,375 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMaxN(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected int
+		wantErr  bool
+	}{
+		{name: "single_value", values: []int{7}, expected: 7},
+		{name: "many_values", values: []int{3, 9, 1, 4, 9, 2}, expected: 9},
+		{name: "max_first", values: []int{10, 1, 2}, expected: 10},
+		{name: "all_negative", values: []int{-5, -2, -9}, expected: -2},
+		{name: "empty_input", values: nil, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := MaxN(tt.values...)
+
+			if tt.wantErr {
+				if err == nil || err.Error() != "no values provided" {
+					t.Fatalf("MaxN(%v) error = %v, want %q", tt.values, err, "no values provided")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("MaxN(%v) unexpected error: %v", tt.values, err)
+			}
+			if result != tt.expected {
+				t.Errorf("MaxN(%v) = %d, want %d", tt.values, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMinN(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected int
+		wantErr  bool
+	}{
+		{name: "single_value", values: []int{7}, expected: 7},
+		{name: "many_values", values: []int{3, 9, 1, 4, 1, 2}, expected: 1},
+		{name: "min_last", values: []int{10, 8, 2}, expected: 2},
+		{name: "all_negative", values: []int{-5, -2, -9}, expected: -9},
+		{name: "empty_input", values: nil, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := MinN(tt.values...)
+
+			if tt.wantErr {
+				if err == nil || err.Error() != "no values provided" {
+					t.Fatalf("MinN(%v) error = %v, want %q", tt.values, err, "no values provided")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("MinN(%v) unexpected error: %v", tt.values, err)
+			}
+			if result != tt.expected {
+				t.Errorf("MinN(%v) = %d, want %d", tt.values, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	tests := []struct {
+		name             string
+		values           []int
+		wantMin, wantMax int
+		wantErr          bool
+	}{
+		{name: "single_value", values: []int{42}, wantMin: 42, wantMax: 42},
+		{name: "ascending", values: []int{1, 2, 3, 4}, wantMin: 1, wantMax: 4},
+		{name: "descending", values: []int{4, 3, 2, 1}, wantMin: 1, wantMax: 4},
+		{name: "mixed_signs", values: []int{0, -7, 12, 3, -1}, wantMin: -7, wantMax: 12},
+		{name: "all_equal", values: []int{5, 5, 5}, wantMin: 5, wantMax: 5},
+		{name: "empty_input", values: nil, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			min, max, err := MinMax(tt.values...)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("MinMax(%v) expected error but got none", tt.values)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("MinMax(%v) unexpected error: %v", tt.values, err)
+			}
+			if min != tt.wantMin || max != tt.wantMax {
+				t.Errorf("MinMax(%v) = (%d, %d), want (%d, %d)", tt.values, min, max, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestMaxOfMinOf_Int(t *testing.T) {
+	tests := []struct {
+		name             string
+		a, b             int
+		wantMax, wantMin int
+	}{
+		{name: "first_larger", a: 9, b: 3, wantMax: 9, wantMin: 3},
+		{name: "second_larger", a: -4, b: 2, wantMax: 2, wantMin: -4},
+		{name: "equal", a: 5, b: 5, wantMax: 5, wantMin: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MaxOf(tt.a, tt.b); got != tt.wantMax {
+				t.Errorf("MaxOf(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.wantMax)
+			}
+			if got := MinOf(tt.a, tt.b); got != tt.wantMin {
+				t.Errorf("MinOf(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.wantMin)
+			}
+		})
+	}
+}
+
+func TestMaxOfMinOf_Float64(t *testing.T) {
+	tests := []struct {
+		name             string
+		a, b             float64
+		wantMax, wantMin float64
+	}{
+		{name: "fractions", a: 1.25, b: 1.5, wantMax: 1.5, wantMin: 1.25},
+		{name: "negatives", a: -0.1, b: -2.5, wantMax: -0.1, wantMin: -2.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MaxOf(tt.a, tt.b); got != tt.wantMax {
+				t.Errorf("MaxOf(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.wantMax)
+			}
+			if got := MinOf(tt.a, tt.b); got != tt.wantMin {
+				t.Errorf("MinOf(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.wantMin)
+			}
+		})
+	}
+}
+
+func TestMaxOfMinOf_String(t *testing.T) {
+	tests := []struct {
+		name             string
+		a, b             string
+		wantMax, wantMin string
+	}{
+		{name: "lexical_order", a: "apple", b: "banana", wantMax: "banana", wantMin: "apple"},
+		{name: "prefix", a: "go", b: "gopher", wantMax: "gopher", wantMin: "go"},
+		{name: "case_sensitive", a: "Zebra", b: "apple", wantMax: "apple", wantMin: "Zebra"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MaxOf(tt.a, tt.b); got != tt.wantMax {
+				t.Errorf("MaxOf(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.wantMax)
+			}
+			if got := MinOf(tt.a, tt.b); got != tt.wantMin {
+				t.Errorf("MinOf(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.wantMin)
+			}
+		})
+	}
+}
+
+func TestClamp(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    int
+		lo, hi   int
+		expected int
+		wantErr  bool
+	}{
+		{name: "below_min", value: -5, lo: 0, hi: 10, expected: 0},
+		{name: "above_max", value: 15, lo: 0, hi: 10, expected: 10},
+		{name: "within_range", value: 7, lo: 0, hi: 10, expected: 7},
+		{name: "on_lower_bound", value: 0, lo: 0, hi: 10, expected: 0},
+		{name: "on_upper_bound", value: 10, lo: 0, hi: 10, expected: 10},
+		{name: "single_point_range", value: 3, lo: 5, hi: 5, expected: 5},
+		{name: "negative_range", value: -50, lo: -20, hi: -10, expected: -20},
+		{name: "min_greater_than_max", value: 5, lo: 10, hi: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Clamp(tt.value, tt.lo, tt.hi)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Clamp(%d, %d, %d) = %d, want error", tt.value, tt.lo, tt.hi, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Clamp(%d, %d, %d) unexpected error: %v", tt.value, tt.lo, tt.hi, err)
+			}
+			if result != tt.expected {
+				t.Errorf("Clamp(%d, %d, %d) = %d, want %d", tt.value, tt.lo, tt.hi, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestClampOf(t *testing.T) {
+	if got := ClampOf(1.5, 0.0, 1.0); got != 1.0 {
+		t.Errorf("ClampOf(1.5, 0.0, 1.0) = %v, want 1", got)
+	}
+	if got := ClampOf(-0.25, 0.0, 1.0); got != 0.0 {
+		t.Errorf("ClampOf(-0.25, 0.0, 1.0) = %v, want 0", got)
+	}
+	if got := ClampOf(0.5, 0.0, 1.0); got != 0.5 {
+		t.Errorf("ClampOf(0.5, 0.0, 1.0) = %v, want 0.5", got)
+	}
+	if got := ClampOf("zebra", "apple", "mango"); got != "mango" {
+		t.Errorf("ClampOf(%q, %q, %q) = %q, want %q", "zebra", "apple", "mango", got, "mango")
+	}
+}
+
+func TestRoundToMultiple(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    float64
+		multiple float64
+		expected float64
+		wantErr  bool
+	}{
+		{name: "rounds_up", value: 23, multiple: 5, expected: 25},
+		{name: "rounds_down", value: 22, multiple: 5, expected: 20},
+		{name: "half_away_from_zero", value: 22.5, multiple: 5, expected: 25},
+		{name: "already_multiple", value: 30, multiple: 10, expected: 30},
+		{name: "negative_value", value: -23, multiple: 5, expected: -25},
+		{name: "negative_multiple", value: 23, multiple: -5, expected: 25},
+		{name: "price_tick", value: 0.37, multiple: 0.05, expected: 0.35},
+		{name: "quarter", value: 1.13, multiple: 0.25, expected: 1.25},
+		{name: "representation_error", value: 0.3, multiple: 0.1, expected: 0.3},
+		{name: "zero_multiple", value: 23, multiple: 0, wantErr: true},
+		{name: "nan", value: math.NaN(), multiple: 5, wantErr: true},
+		{name: "infinite_multiple", value: 23, multiple: math.Inf(1), wantErr: true},
+		{name: "overflow", value: math.MaxFloat64, multiple: 1e-10, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := RoundToMultiple(tt.value, tt.multiple)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("RoundToMultiple(%v, %v) = %v, want error", tt.value, tt.multiple, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RoundToMultiple(%v, %v) unexpected error: %v", tt.value, tt.multiple, err)
+			}
+			if result != tt.expected {
+				t.Errorf("RoundToMultiple(%v, %v) = %v, want %v", tt.value, tt.multiple, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLerp(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b, t  float64
+		expected float64
+	}{
+		{name: "midpoint", a: 0, b: 10, t: 0.5, expected: 5},
+		{name: "start", a: 3, b: 7, t: 0, expected: 3},
+		{name: "end", a: 0.1, b: 0.7, t: 1, expected: 0.7},
+		{name: "quarter", a: 10, b: 20, t: 0.25, expected: 12.5},
+		{name: "descending", a: 10, b: 0, t: 0.3, expected: 7},
+		{name: "extrapolate", a: 0, b: 10, t: 1.5, expected: 15},
+		{name: "equal_ends", a: 4, b: 4, t: 0.8, expected: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Lerp(tt.a, tt.b, tt.t); math.Abs(got-tt.expected) > 1e-12 {
+				t.Errorf("Lerp(%v, %v, %v) = %v, want %v", tt.a, tt.b, tt.t, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMapRange(t *testing.T) {
+	tests := []struct {
+		name           string
+		value          float64
+		inMin, inMax   float64
+		outMin, outMax float64
+		expected       float64
+		wantErr        bool
+	}{
+		{name: "percent", value: 5, inMin: 0, inMax: 10, outMin: 0, outMax: 100, expected: 50},
+		{name: "celsius_to_fahrenheit", value: 37, inMin: 0, inMax: 100, outMin: 32, outMax: 212, expected: 98.6},
+		{name: "byte_to_unit", value: 51, inMin: 0, inMax: 255, outMin: 0, outMax: 1, expected: 0.2},
+		{name: "inverted_output", value: 2, inMin: 0, inMax: 8, outMin: 1, outMax: -1, expected: 0.5},
+		{name: "descending_input", value: 7.5, inMin: 10, inMax: 0, outMin: 0, outMax: 1, expected: 0.25},
+		{name: "extrapolate", value: 15, inMin: 0, inMax: 10, outMin: 0, outMax: 100, expected: 150},
+		{name: "huge_input_range_end", value: 1e308, inMin: -1e308, inMax: 1e308, outMin: 0, outMax: 1, expected: 1},
+		{name: "huge_input_range_middle", value: 0, inMin: -1e308, inMax: 1e308, outMin: 0, outMax: 1, expected: 0.5},
+		{name: "huge_value_offset", value: 1e308, inMin: -1e308, inMax: 0, outMin: 0, outMax: 1, expected: 2},
+		{name: "huge_output_range", value: 0.75, inMin: 0, inMax: 1, outMin: -1e308, outMax: 1e308, expected: 5e307},
+		{name: "empty_input_range", value: 5, inMin: 3, inMax: 3, outMin: 0, outMax: 1, wantErr: true},
+		{name: "nan", value: math.NaN(), inMin: 0, inMax: 1, outMin: 0, outMax: 1, wantErr: true},
+		{name: "infinite_bound", value: 1, inMin: 0, inMax: math.Inf(1), outMin: 0, outMax: 1, wantErr: true},
+		{name: "overflow", value: math.MaxFloat64, inMin: 0, inMax: 1, outMin: 0, outMax: 10, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := MapRange(tt.value, tt.inMin, tt.inMax, tt.outMin, tt.outMax)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("MapRange(%v, %v, %v, %v, %v) = %v, want error", tt.value, tt.inMin, tt.inMax, tt.outMin, tt.outMax, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MapRange(%v, %v, %v, %v, %v) unexpected error: %v", tt.value, tt.inMin, tt.inMax, tt.outMin, tt.outMax, err)
+			}
+			if math.Abs(result-tt.expected) > 1e-9 {
+				t.Errorf("MapRange(%v, %v, %v, %v, %v) = %v, want %v", tt.value, tt.inMin, tt.inMax, tt.outMin, tt.outMax, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDegreesToRadians(t *testing.T) {
+	tests := []struct {
+		name     string
+		degrees  float64
+		expected float64
+	}{
+		{name: "zero", degrees: 0, expected: 0},
+		{name: "right_angle", degrees: 90, expected: math.Pi / 2},
+		{name: "straight_angle", degrees: 180, expected: math.Pi},
+		{name: "full_turn", degrees: 360, expected: 2 * math.Pi},
+		{name: "negative", degrees: -45, expected: -math.Pi / 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DegreesToRadians(tt.degrees); math.Abs(got-tt.expected) > 1e-12 {
+				t.Errorf("DegreesToRadians(%v) = %v, want %v", tt.degrees, got, tt.expected)
+			}
+			if got := RadiansToDegrees(tt.expected); math.Abs(got-tt.degrees) > 1e-12 {
+				t.Errorf("RadiansToDegrees(%v) = %v, want %v", tt.expected, got, tt.degrees)
+			}
+		})
+	}
+}
+
+func TestDegreesRadiansRoundTrip(t *testing.T) {
+	for _, d := range []float64{-720, -30, 1, 57.29577951308232, 123.456, 1e6} {
+		if got := RadiansToDegrees(DegreesToRadians(d)); math.Abs(got-d) > 1e-9*math.Max(1, math.Abs(d)) {
+			t.Errorf("RadiansToDegrees(DegreesToRadians(%v)) = %v", d, got)
+		}
+	}
+}
@@ -0,0 +1,1847 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCalculatorSubtract(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     float64
+		expected float64
+		wantErr  string
+	}{
+		{name: "positive_operands", a: 10, b: 4, expected: 6},
+		{name: "negative_result", a: 4, b: 10, expected: -6},
+		{name: "negative_operands", a: -2.5, b: -1.5, expected: -1},
+		{name: "nan_input", a: math.NaN(), b: 1, wantErr: "NaN values not allowed"},
+		{name: "positive_inf_input", a: math.Inf(1), b: 1, wantErr: "infinite values not allowed"},
+		{name: "negative_inf_input", a: 1, b: math.Inf(-1), wantErr: "infinite values not allowed"},
+		{name: "overflow", a: math.MaxFloat64, b: -math.MaxFloat64, wantErr: "arithmetic overflow"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			result, err := calc.Subtract(tt.a, tt.b)
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Subtract(%v, %v) error = %v, want %q", tt.a, tt.b, err, tt.wantErr)
+				}
+				if len(calc.History) != 0 {
+					t.Errorf("History = %v, want empty after error", calc.History)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Subtract(%v, %v) unexpected error: %v", tt.a, tt.b, err)
+			}
+			if result != tt.expected || calc.Result != tt.expected {
+				t.Errorf("Subtract(%v, %v) = %v (Result %v), want %v", tt.a, tt.b, result, calc.Result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculatorAddDivide_Overflow(t *testing.T) {
+	tests := []struct {
+		name string
+		op   func(*Calculator) (float64, error)
+	}{
+		{name: "add_positive", op: func(c *Calculator) (float64, error) { return c.Add(1.7e308, 1.7e308) }},
+		{name: "add_negative", op: func(c *Calculator) (float64, error) { return c.Add(-1.7e308, -1.7e308) }},
+		{name: "divide_by_tiny", op: func(c *Calculator) (float64, error) { return c.Divide(1e308, 1e-10) }},
+		{name: "divide_negative", op: func(c *Calculator) (float64, error) { return c.Divide(-1e308, 0.5) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			result, err := tt.op(calc)
+			if !errors.Is(err, ErrOverflow) {
+				t.Fatalf("result = %v, error = %v, want %v", result, err, ErrOverflow)
+			}
+			if calc.Result != 0 || len(calc.History) != 0 {
+				t.Errorf("Result = %v, History = %v, want nothing recorded", calc.Result, calc.History)
+			}
+		})
+	}
+}
+
+func TestCalculatorAddDivide_LargeFinite(t *testing.T) {
+	calc := NewCalculator()
+	if result, err := calc.Add(math.MaxFloat64, -1e308); err != nil || math.IsInf(result, 0) {
+		t.Errorf("Add(MaxFloat64, -1e308) = %v, %v, want a finite result", result, err)
+	}
+	if result, err := calc.Divide(1e308, 2); err != nil || result != 5e307 {
+		t.Errorf("Divide(1e308, 2) = %v, %v, want 5e307", result, err)
+	}
+}
+
+func TestCalculatorAddChecked(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     float64
+		expected float64
+		wantLoss bool
+		wantErr  bool
+	}{
+		{name: "one_absorbed", a: 1e16, b: 1, expected: 1e16, wantLoss: true},
+		{name: "one_absorbed_reversed", a: 1, b: 1e16, expected: 1e16, wantLoss: true},
+		{name: "partially_absorbed", a: 1e12, b: 0.0001234, expected: 1e12 + 0.0001234, wantLoss: true},
+		{name: "exact_integers", a: 10, b: 5, expected: 15},
+		{name: "ordinary_rounding", a: 0.1, b: 0.2, expected: 0.30000000000000004},
+		{name: "large_exact", a: 1e15, b: 1, expected: 1e15 + 1},
+		{name: "cancellation", a: 1.0000001, b: -1, expected: 1.0000000005838672e-07},
+		{name: "zero_operand", a: 0, b: 1e300, expected: 1e300},
+		{name: "nan_input", a: math.NaN(), b: 1, wantErr: true},
+		{name: "overflow", a: 1.7e308, b: 1.7e308, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			result, lost, err := calc.AddChecked(tt.a, tt.b)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("AddChecked(%v, %v) = %v, %v, want error", tt.a, tt.b, result, lost)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AddChecked(%v, %v) unexpected error: %v", tt.a, tt.b, err)
+			}
+			if result != tt.expected || lost != tt.wantLoss {
+				t.Errorf("AddChecked(%v, %v) = %v, %v, want %v, %v", tt.a, tt.b, result, lost, tt.expected, tt.wantLoss)
+			}
+			if len(calc.History) != 1 {
+				t.Errorf("History = %v, want one entry", calc.History)
+			}
+		})
+	}
+}
+
+func TestCalculatorSubtract_History(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Subtract(10, 4); err != nil {
+		t.Fatalf("Subtract(10, 4) unexpected error: %v", err)
+	}
+
+	want := "10.00 - 4.00 = 6.00"
+	if len(calc.History) != 1 || calc.History[0] != want {
+		t.Errorf("History = %v, want [%q]", calc.History, want)
+	}
+}
+
+func TestCalculatorMultiply(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     float64
+		expected float64
+		wantErr  string
+	}{
+		{name: "positive_operands", a: 6, b: 7, expected: 42},
+		{name: "mixed_signs", a: -3, b: 2.5, expected: -7.5},
+		{name: "zero_operand", a: 0, b: 123, expected: 0},
+		{name: "nan_input", a: math.NaN(), b: 2, wantErr: "NaN values not allowed"},
+		{name: "inf_input", a: 2, b: math.Inf(1), wantErr: "infinite values not allowed"},
+		{name: "positive_overflow", a: 1e300, b: 1e300, wantErr: "arithmetic overflow"},
+		{name: "negative_overflow", a: -1e300, b: 1e300, wantErr: "arithmetic overflow"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			result, err := calc.Multiply(tt.a, tt.b)
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Multiply(%v, %v) error = %v, want %q", tt.a, tt.b, err, tt.wantErr)
+				}
+				if calc.Result != 0 || len(calc.History) != 0 {
+					t.Errorf("calculator state changed after error: Result %v, History %v", calc.Result, calc.History)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Multiply(%v, %v) unexpected error: %v", tt.a, tt.b, err)
+			}
+			if result != tt.expected || calc.Result != tt.expected {
+				t.Errorf("Multiply(%v, %v) = %v (Result %v), want %v", tt.a, tt.b, result, calc.Result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculatorMultiply_History(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Multiply(6, 7); err != nil {
+		t.Fatalf("Multiply(6, 7) unexpected error: %v", err)
+	}
+
+	want := "6.00 * 7.00 = 42.00"
+	if len(calc.History) != 1 || calc.History[0] != want {
+		t.Errorf("History = %v, want [%q]", calc.History, want)
+	}
+}
+
+func TestCalculatorPower(t *testing.T) {
+	tests := []struct {
+		name      string
+		base, exp float64
+		expected  float64
+		wantErr   string
+	}{
+		{name: "integer_exponent", base: 2, exp: 10, expected: 1024},
+		{name: "zero_to_the_zero", base: 0, exp: 0, expected: 1},
+		{name: "negative_exponent_reciprocal", base: 2, exp: -2, expected: 0.25},
+		{name: "negative_base_even_exponent", base: -3, exp: 2, expected: 9},
+		{name: "negative_base_odd_exponent", base: -2, exp: 3, expected: -8},
+		{name: "fractional_exponent", base: 9, exp: 0.5, expected: 3},
+		{name: "negative_base_fractional_exponent", base: -8, exp: 0.5, wantErr: "result is not a real number"},
+		{name: "zero_base_negative_exponent", base: 0, exp: -1, wantErr: "division by zero is not allowed"},
+		{name: "overflow", base: 10, exp: 400, wantErr: "arithmetic overflow"},
+		{name: "nan_input", base: math.NaN(), exp: 2, wantErr: "NaN values not allowed"},
+		{name: "inf_input", base: 2, exp: math.Inf(1), wantErr: "infinite values not allowed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			result, err := calc.Power(tt.base, tt.exp)
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Power(%v, %v) error = %v, want %q", tt.base, tt.exp, err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Power(%v, %v) unexpected error: %v", tt.base, tt.exp, err)
+			}
+			if result != tt.expected || calc.Result != tt.expected {
+				t.Errorf("Power(%v, %v) = %v (Result %v), want %v", tt.base, tt.exp, result, calc.Result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculatorPower_History(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Power(2, 3); err != nil {
+		t.Fatalf("Power(2, 3) unexpected error: %v", err)
+	}
+
+	want := "2.00 ^ 3.00 = 8.00"
+	if len(calc.History) != 1 || calc.History[0] != want {
+		t.Errorf("History = %v, want [%q]", calc.History, want)
+	}
+}
+
+func TestCalculatorSquareRoot(t *testing.T) {
+	tests := []struct {
+		name     string
+		x        float64
+		expected float64
+		wantErr  string
+	}{
+		{name: "perfect_square", x: 16, expected: 4},
+		{name: "zero", x: 0, expected: 0},
+		{name: "fraction", x: 0.25, expected: 0.5},
+		{name: "large_value", x: 1e308, expected: 1e154},
+		{name: "negative", x: -4, wantErr: "cannot take square root of negative number"},
+		{name: "nan_input", x: math.NaN(), wantErr: "NaN values not allowed"},
+		{name: "inf_input", x: math.Inf(1), wantErr: "infinite values not allowed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			result, err := calc.SquareRoot(tt.x)
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("SquareRoot(%v) error = %v, want %q", tt.x, err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("SquareRoot(%v) unexpected error: %v", tt.x, err)
+			}
+			if math.IsInf(result, 0) || result != tt.expected || calc.Result != tt.expected {
+				t.Errorf("SquareRoot(%v) = %v (Result %v), want %v", tt.x, result, calc.Result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculatorSquareRoot_History(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.SquareRoot(16); err != nil {
+		t.Fatalf("SquareRoot(16) unexpected error: %v", err)
+	}
+
+	want := "sqrt(16.00) = 4.00"
+	if len(calc.History) != 1 || calc.History[0] != want {
+		t.Errorf("History = %v, want [%q]", calc.History, want)
+	}
+}
+
+func TestCalculatorModulo(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     float64
+		expected float64
+		wantErr  string
+	}{
+		{name: "positive_operands", a: 7, b: 3, expected: 1},
+		{name: "fractional_operands", a: 5.5, b: 2, expected: 1.5},
+		{name: "negative_dividend_keeps_sign", a: -7, b: 3, expected: -1},
+		{name: "negative_divisor_ignores_sign", a: 7, b: -3, expected: 1},
+		{name: "both_negative", a: -7, b: -3, expected: -1},
+		{name: "exact_multiple", a: 9, b: 3, expected: 0},
+		{name: "modulo_by_zero", a: 7, b: 0, wantErr: "modulo by zero is not allowed"},
+		{name: "nan_input", a: math.NaN(), b: 3, wantErr: "NaN values not allowed"},
+		{name: "inf_input", a: math.Inf(-1), b: 3, wantErr: "infinite values not allowed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			result, err := calc.Modulo(tt.a, tt.b)
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Modulo(%v, %v) error = %v, want %q", tt.a, tt.b, err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Modulo(%v, %v) unexpected error: %v", tt.a, tt.b, err)
+			}
+			if result != tt.expected || calc.Result != tt.expected {
+				t.Errorf("Modulo(%v, %v) = %v (Result %v), want %v", tt.a, tt.b, result, calc.Result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculatorModulo_History(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Modulo(7, 3); err != nil {
+		t.Fatalf("Modulo(7, 3) unexpected error: %v", err)
+	}
+
+	want := "7.00 % 3.00 = 1.00"
+	if len(calc.History) != 1 || calc.History[0] != want {
+		t.Errorf("History = %v, want [%q]", calc.History, want)
+	}
+}
+
+func TestCalculatorSum(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		expected float64
+		wantErr  string
+	}{
+		{name: "empty_input", values: nil, expected: 0},
+		{name: "single_value", values: []float64{4.5}, expected: 4.5},
+		{name: "many_values", values: []float64{1, 2, 3, 4}, expected: 10},
+		{name: "mixed_signs", values: []float64{10, -2.5, -7.5}, expected: 0},
+		{name: "overflowing_total", values: []float64{1e308, 1e308, -1e308}, wantErr: "arithmetic overflow"},
+		{name: "nan_value", values: []float64{1, math.NaN()}, wantErr: "NaN values not allowed"},
+		{name: "inf_value", values: []float64{math.Inf(1), 1}, wantErr: "infinite values not allowed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			result, err := calc.Sum(tt.values...)
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Sum(%v) error = %v, want %q", tt.values, err, tt.wantErr)
+				}
+				if len(calc.History) != 0 {
+					t.Errorf("History = %v, want empty after error", calc.History)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Sum(%v) unexpected error: %v", tt.values, err)
+			}
+			if result != tt.expected || calc.Result != tt.expected {
+				t.Errorf("Sum(%v) = %v (Result %v), want %v", tt.values, result, calc.Result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculatorSum_History(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Sum(1, 2, 3); err != nil {
+		t.Fatalf("Sum(1, 2, 3) unexpected error: %v", err)
+	}
+
+	want := "sum(3 values) = 6.00"
+	if len(calc.History) != 1 || calc.History[0] != want {
+		t.Errorf("History = %v, want [%q]", calc.History, want)
+	}
+}
+
+func TestCalculatorUndo(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Add(10, 5); err != nil {
+		t.Fatalf("Add(10, 5) unexpected error: %v", err)
+	}
+	if _, err := calc.Multiply(15, 2); err != nil {
+		t.Fatalf("Multiply(15, 2) unexpected error: %v", err)
+	}
+	if _, err := calc.Subtract(30, 8); err != nil {
+		t.Fatalf("Subtract(30, 8) unexpected error: %v", err)
+	}
+
+	steps := []struct {
+		wantResult  float64
+		wantHistory int
+	}{
+		{wantResult: 30, wantHistory: 2},
+		{wantResult: 15, wantHistory: 1},
+		{wantResult: 0, wantHistory: 0},
+	}
+
+	for i, step := range steps {
+		if err := calc.Undo(); err != nil {
+			t.Fatalf("Undo #%d unexpected error: %v", i+1, err)
+		}
+		if calc.Result != step.wantResult || len(calc.History) != step.wantHistory {
+			t.Errorf("after Undo #%d: Result %v, History %v; want Result %v with %d entries",
+				i+1, calc.Result, calc.History, step.wantResult, step.wantHistory)
+		}
+	}
+
+	if err := calc.Undo(); err == nil || err.Error() != "nothing to undo" {
+		t.Errorf("Undo on empty history error = %v, want %q", err, "nothing to undo")
+	}
+}
+
+func TestCalculatorUndo_Empty(t *testing.T) {
+	calc := NewCalculator()
+	if err := calc.Undo(); err == nil || err.Error() != "nothing to undo" {
+		t.Errorf("Undo() error = %v, want %q", err, "nothing to undo")
+	}
+}
+
+func TestCalculatorUndo_AfterClearHistory(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Add(1, 2); err != nil {
+		t.Fatalf("Add(1, 2) unexpected error: %v", err)
+	}
+	calc.ClearHistory()
+
+	if err := calc.Undo(); err == nil {
+		t.Errorf("Undo() after ClearHistory expected error, got nil")
+	}
+}
+
+func TestCalculatorUndo_HistoryClearedDirectly(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Add(1, 2); err != nil {
+		t.Fatalf("Add(1, 2) unexpected error: %v", err)
+	}
+	calc.History = nil
+
+	if err := calc.Undo(); err == nil || err.Error() != "nothing to undo" {
+		t.Errorf("Undo() after History = nil error = %v, want %q", err, "nothing to undo")
+	}
+	if calc.Result != 3 {
+		t.Errorf("Result = %v, want 3", calc.Result)
+	}
+}
+
+func TestCalculatorRedo(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Add(10, 5); err != nil {
+		t.Fatalf("Add(10, 5) unexpected error: %v", err)
+	}
+	if _, err := calc.Multiply(15, 2); err != nil {
+		t.Fatalf("Multiply(15, 2) unexpected error: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := calc.Undo(); err != nil {
+			t.Fatalf("Undo #%d unexpected error: %v", i+1, err)
+		}
+	}
+
+	if err := calc.Redo(); err != nil {
+		t.Fatalf("first Redo unexpected error: %v", err)
+	}
+	if calc.Result != 15 || len(calc.History) != 1 || calc.History[0] != "10.00 + 5.00 = 15.00" {
+		t.Errorf("after first Redo: Result %v, History %v", calc.Result, calc.History)
+	}
+
+	if err := calc.Redo(); err != nil {
+		t.Fatalf("second Redo unexpected error: %v", err)
+	}
+	if calc.Result != 30 || len(calc.History) != 2 || calc.History[1] != "15.00 * 2.00 = 30.00" {
+		t.Errorf("after second Redo: Result %v, History %v", calc.Result, calc.History)
+	}
+
+	if err := calc.Redo(); err == nil || err.Error() != "nothing to redo" {
+		t.Errorf("Redo with nothing undone error = %v, want %q", err, "nothing to redo")
+	}
+
+	// Redone operations can be undone again
+	if err := calc.Undo(); err != nil || calc.Result != 15 {
+		t.Errorf("Undo after Redo: err %v, Result %v; want nil, 15", err, calc.Result)
+	}
+}
+
+func TestCalculatorRedo_DiscardedByNewOperation(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Add(10, 5); err != nil {
+		t.Fatalf("Add(10, 5) unexpected error: %v", err)
+	}
+	if _, err := calc.Add(1, 1); err != nil {
+		t.Fatalf("Add(1, 1) unexpected error: %v", err)
+	}
+	if err := calc.Undo(); err != nil {
+		t.Fatalf("Undo unexpected error: %v", err)
+	}
+
+	if _, err := calc.Add(2, 3); err != nil {
+		t.Fatalf("Add(2, 3) unexpected error: %v", err)
+	}
+
+	if err := calc.Redo(); err == nil || err.Error() != "nothing to redo" {
+		t.Errorf("Redo after new operation error = %v, want %q", err, "nothing to redo")
+	}
+	want := []string{"10.00 + 5.00 = 15.00", "2.00 + 3.00 = 5.00"}
+	if len(calc.History) != len(want) || calc.History[0] != want[0] || calc.History[1] != want[1] {
+		t.Errorf("History = %v, want %v", calc.History, want)
+	}
+	if calc.Result != 5 {
+		t.Errorf("Result = %v, want 5", calc.Result)
+	}
+}
+
+func TestCalculatorRedo_FailedOperationKeepsRedo(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Add(10, 5); err != nil {
+		t.Fatalf("Add(10, 5) unexpected error: %v", err)
+	}
+	if err := calc.Undo(); err != nil {
+		t.Fatalf("Undo unexpected error: %v", err)
+	}
+
+	if _, err := calc.Divide(1, 0); err == nil {
+		t.Fatalf("Divide(1, 0) expected error")
+	}
+
+	if err := calc.Redo(); err != nil || calc.Result != 15 {
+		t.Errorf("Redo after failed operation: err %v, Result %v; want nil, 15", err, calc.Result)
+	}
+}
+
+func TestCalculatorMemory(t *testing.T) {
+	calc := NewCalculator()
+	if got := calc.MemoryRecall(); got != 0 {
+		t.Fatalf("MemoryRecall() on new calculator = %v, want 0", got)
+	}
+
+	steps := []struct {
+		name   string
+		op     func() (float64, error)
+		memory func()
+		want   float64
+	}{
+		{name: "add_then_m_plus", op: func() (float64, error) { return calc.Add(10, 5) }, memory: calc.MemoryAdd, want: 15},
+		{name: "multiply_then_m_plus", op: func() (float64, error) { return calc.Multiply(2, 3) }, memory: calc.MemoryAdd, want: 21},
+		{name: "subtract_then_m_minus", op: func() (float64, error) { return calc.Subtract(5, 1) }, memory: calc.MemorySubtract, want: 17},
+		{name: "divide_then_m_minus", op: func() (float64, error) { return calc.Divide(1, 4) }, memory: calc.MemorySubtract, want: 16.75},
+	}
+
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			if _, err := step.op(); err != nil {
+				t.Fatalf("operation unexpected error: %v", err)
+			}
+			step.memory()
+			if got := calc.MemoryRecall(); got != step.want {
+				t.Errorf("MemoryRecall() = %v, want %v", got, step.want)
+			}
+		})
+	}
+
+	calc.MemoryClear()
+	if got := calc.MemoryRecall(); got != 0 {
+		t.Errorf("MemoryRecall() after MemoryClear = %v, want 0", got)
+	}
+}
+
+func TestCalculatorMemory_IndependentOfHistory(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Add(2, 2); err != nil {
+		t.Fatalf("Add(2, 2) unexpected error: %v", err)
+	}
+	calc.MemoryAdd()
+	calc.ClearHistory()
+
+	if got := calc.MemoryRecall(); got != 4 {
+		t.Errorf("MemoryRecall() after ClearHistory = %v, want 4", got)
+	}
+}
+
+func TestCalculatorAccumulator(t *testing.T) {
+	calc := NewCalculator()
+	if got := calc.RunningMean(); got != 0 {
+		t.Errorf("RunningMean() with no samples = %v, want 0", got)
+	}
+
+	steps := []struct {
+		value    float64
+		wantMean float64
+	}{
+		{value: 4, wantMean: 4},
+		{value: 8, wantMean: 6},
+		{value: 0, wantMean: 4},
+		{value: -4, wantMean: 2},
+		{value: math.NaN(), wantMean: 2},
+		{value: math.Inf(1), wantMean: 2},
+		{value: 12, wantMean: 4},
+	}
+	for _, step := range steps {
+		calc.Accumulate(step.value)
+		if got := calc.RunningMean(); got != step.wantMean {
+			t.Errorf("RunningMean() after Accumulate(%v) = %v, want %v", step.value, got, step.wantMean)
+		}
+	}
+
+	calc.ResetAccumulator()
+	if got := calc.RunningMean(); got != 0 {
+		t.Errorf("RunningMean() after ResetAccumulator() = %v, want 0", got)
+	}
+	calc.Accumulate(1.5)
+	if got := calc.RunningMean(); got != 1.5 {
+		t.Errorf("RunningMean() after reset and Accumulate(1.5) = %v, want 1.5", got)
+	}
+}
+
+func TestCalculatorAccumulator_Concurrent(t *testing.T) {
+	calc := NewCalculator()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(v float64) {
+			defer wg.Done()
+			calc.Accumulate(v)
+			calc.RunningMean()
+		}(float64(i % 2))
+	}
+	wg.Wait()
+
+	if got := calc.RunningMean(); got != 0.5 {
+		t.Errorf("RunningMean() = %v, want 0.5", got)
+	}
+}
+
+func TestCalculatorMaxHistory(t *testing.T) {
+	calc := NewCalculator()
+	calc.SetMaxHistory(2)
+
+	for _, v := range []float64{1, 2, 3, 4} {
+		if _, err := calc.Add(v, v); err != nil {
+			t.Fatalf("Add(%v, %v) unexpected error: %v", v, v, err)
+		}
+	}
+
+	want := []string{"3.00 + 3.00 = 6.00", "4.00 + 4.00 = 8.00"}
+	if len(calc.History) != len(want) || calc.History[0] != want[0] || calc.History[1] != want[1] {
+		t.Errorf("History = %v, want %v", calc.History, want)
+	}
+	if calc.Result != 8 {
+		t.Errorf("Result = %v, want 8", calc.Result)
+	}
+}
+
+func TestCalculatorSetMaxHistory_TrimsExisting(t *testing.T) {
+	calc := NewCalculator()
+	for _, v := range []float64{1, 2, 3} {
+		if _, err := calc.Add(v, 0); err != nil {
+			t.Fatalf("Add(%v, 0) unexpected error: %v", v, err)
+		}
+	}
+
+	calc.SetMaxHistory(1)
+
+	if len(calc.History) != 1 || calc.History[0] != "3.00 + 0.00 = 3.00" {
+		t.Errorf("History = %v, want only the newest entry", calc.History)
+	}
+}
+
+func TestCalculatorSetMaxHistory_Unlimited(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int
+	}{
+		{name: "zero", limit: 0},
+		{name: "negative", limit: -5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			calc.SetMaxHistory(tt.limit)
+			for i := 0; i < 50; i++ {
+				if _, err := calc.Add(float64(i), 1); err != nil {
+					t.Fatalf("Add unexpected error: %v", err)
+				}
+			}
+			if len(calc.History) != 50 {
+				t.Errorf("len(History) = %d, want 50", len(calc.History))
+			}
+		})
+	}
+}
+
+func TestCalculatorMaxHistory_UndoLimited(t *testing.T) {
+	calc := NewCalculator()
+	calc.SetMaxHistory(2)
+	for _, v := range []float64{1, 2, 3} {
+		if _, err := calc.Add(v, 0); err != nil {
+			t.Fatalf("Add(%v, 0) unexpected error: %v", v, err)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := calc.Undo(); err != nil {
+			t.Fatalf("Undo #%d unexpected error: %v", i+1, err)
+		}
+	}
+	if calc.Result != 1 || len(calc.History) != 0 {
+		t.Errorf("after undoing retained entries: Result %v, History %v; want 1, empty", calc.Result, calc.History)
+	}
+	if err := calc.Undo(); err == nil {
+		t.Errorf("Undo past the history limit expected error, got nil")
+	}
+}
+
+// Run with -race to detect unsynchronized access to calculator state
+func TestCalculator_ConcurrentAdd(t *testing.T) {
+	const goroutines, perGoroutine = 50, 100
+	calc := NewCalculator()
+
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perGoroutine; j++ {
+				if _, err := calc.Add(1, 2); err != nil {
+					t.Errorf("Add(1, 2) unexpected error: %v", err)
+					return
+				}
+				_ = calc.CurrentResult()
+				_ = calc.GetHistory()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := len(calc.GetHistory()); got != goroutines*perGoroutine {
+		t.Errorf("len(GetHistory()) = %d, want %d", got, goroutines*perGoroutine)
+	}
+	if got := calc.CurrentResult(); got != 3 {
+		t.Errorf("CurrentResult() = %v, want 3", got)
+	}
+}
+
+func TestCalculator_ConcurrentMixedOperations(t *testing.T) {
+	calc := NewCalculator()
+	calc.SetMaxHistory(100)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				switch (i + j) % 5 {
+				case 0:
+					_, _ = calc.Multiply(float64(j), 2)
+				case 1:
+					_, _ = calc.Divide(float64(j), 3)
+				case 2:
+					_ = calc.Undo()
+				case 3:
+					_ = calc.Redo()
+				default:
+					calc.MemoryAdd()
+					_ = calc.MemoryRecall()
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if got := len(calc.GetHistory()); got > 100 {
+		t.Errorf("len(GetHistory()) = %d, want at most 100", got)
+	}
+}
+
+func TestCalculatorCurrentResult(t *testing.T) {
+	calc := NewCalculator()
+	if got := calc.CurrentResult(); got != 0 {
+		t.Errorf("CurrentResult() on new calculator = %v, want 0", got)
+	}
+
+	if _, err := calc.Subtract(10, 3); err != nil {
+		t.Fatalf("Subtract(10, 3) unexpected error: %v", err)
+	}
+	if got := calc.CurrentResult(); got != 7 {
+		t.Errorf("CurrentResult() = %v, want 7", got)
+	}
+}
+
+func TestCalculatorPercentage(t *testing.T) {
+	tests := []struct {
+		name           string
+		value, percent float64
+		expected       float64
+		wantErr        string
+	}{
+		{name: "whole_percent", value: 200, percent: 15, expected: 30},
+		{name: "fractional_percent", value: 80, percent: 12.5, expected: 10},
+		{name: "over_hundred_percent", value: 50, percent: 150, expected: 75},
+		{name: "negative_percent", value: 40, percent: -25, expected: -10},
+		{name: "zero_value", value: 0, percent: 50, expected: 0},
+		{name: "nan_input", value: math.NaN(), percent: 10, wantErr: "NaN values not allowed"},
+		{name: "inf_input", value: 10, percent: math.Inf(1), wantErr: "infinite values not allowed"},
+		{name: "large_finite_result", value: 1e307, percent: 1000, expected: 1e308},
+		{name: "overflow", value: 1e308, percent: 1e10, wantErr: ErrOverflow.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			result, err := calc.Percentage(tt.value, tt.percent)
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Percentage(%v, %v) error = %v, want %q", tt.value, tt.percent, err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Percentage(%v, %v) unexpected error: %v", tt.value, tt.percent, err)
+			}
+			if result != tt.expected || calc.Result != tt.expected {
+				t.Errorf("Percentage(%v, %v) = %v (Result %v), want %v", tt.value, tt.percent, result, calc.Result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculatorPercentage_History(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Percentage(200, 15); err != nil {
+		t.Fatalf("Percentage(200, 15) unexpected error: %v", err)
+	}
+
+	want := "15.00% of 200.00 = 30.00"
+	if len(calc.History) != 1 || calc.History[0] != want {
+		t.Errorf("History = %v, want [%q]", calc.History, want)
+	}
+}
+
+func TestCalculatorPercentChange(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new float64
+		expected float64
+		wantErr  string
+	}{
+		{name: "increase", old: 50, new: 75, expected: 50},
+		{name: "decrease", old: 200, new: 150, expected: -25},
+		{name: "total_loss", old: 80, new: 0, expected: -100},
+		{name: "no_change", old: 10, new: 10, expected: 0},
+		{name: "negative_old_increase", old: -50, new: -25, expected: 50},
+		{name: "negative_old_decrease", old: -50, new: -100, expected: -100},
+		{name: "zero_old", old: 0, new: 10, wantErr: "percent change from zero is undefined"},
+		{name: "nan_input", old: math.NaN(), new: 10, wantErr: "NaN values not allowed"},
+		{name: "inf_input", old: 10, new: math.Inf(-1), wantErr: "infinite values not allowed"},
+		{name: "overflow", old: 1e-300, new: 1e300, wantErr: ErrOverflow.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			result, err := calc.PercentChange(tt.old, tt.new)
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("PercentChange(%v, %v) error = %v, want %q", tt.old, tt.new, err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("PercentChange(%v, %v) unexpected error: %v", tt.old, tt.new, err)
+			}
+			if result != tt.expected || calc.Result != tt.expected {
+				t.Errorf("PercentChange(%v, %v) = %v (Result %v), want %v", tt.old, tt.new, result, calc.Result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculatorFibonacciBig(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected string
+		wantErr  bool
+	}{
+		{name: "zero", n: 0, expected: "0"},
+		{name: "one", n: 1, expected: "1"},
+		{name: "two", n: 2, expected: "1"},
+		{name: "ten", n: 10, expected: "55"},
+		{name: "int_limit", n: 46, expected: "1836311903"},
+		{name: "beyond_int_limit", n: 47, expected: "2971215073"},
+		{name: "hundred", n: 100, expected: "354224848179261915075"},
+		{name: "negative", n: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewCalculator().FibonacciBig(tt.n)
+
+			if tt.wantErr {
+				if err == nil || err.Error() != "input must be non-negative" {
+					t.Fatalf("FibonacciBig(%d) error = %v, want %q", tt.n, err, "input must be non-negative")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("FibonacciBig(%d) unexpected error: %v", tt.n, err)
+			}
+			if result.String() != tt.expected {
+				t.Errorf("FibonacciBig(%d) = %s, want %s", tt.n, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculatorFibonacciBig_MatchesFibonacci(t *testing.T) {
+	calc := NewCalculator()
+	for n := 0; n <= 46; n++ {
+		want, err := calc.Fibonacci(n)
+		if err != nil {
+			t.Fatalf("Fibonacci(%d) unexpected error: %v", n, err)
+		}
+
+		got, err := calc.FibonacciBig(n)
+		if err != nil {
+			t.Fatalf("FibonacciBig(%d) unexpected error: %v", n, err)
+		}
+		if got.Cmp(big.NewInt(int64(want))) != 0 {
+			t.Errorf("FibonacciBig(%d) = %s, want %d", n, got, want)
+		}
+	}
+}
+
+func TestCalculatorFibonacciBigCtx(t *testing.T) {
+	calc := NewCalculator()
+
+	got, err := calc.FibonacciBigCtx(context.Background(), 100)
+	if err != nil {
+		t.Fatalf("FibonacciBigCtx(100) unexpected error: %v", err)
+	}
+	if got.String() != "354224848179261915075" {
+		t.Errorf("FibonacciBigCtx(100) = %s, want 354224848179261915075", got)
+	}
+
+	if _, err := calc.FibonacciBigCtx(context.Background(), -1); !errors.Is(err, ErrNegative) {
+		t.Errorf("FibonacciBigCtx(-1) error = %v, want %v", err, ErrNegative)
+	}
+}
+
+func TestCalculatorFibonacciBigCtx_CancelledMidComputation(t *testing.T) {
+	calc := NewCalculator()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		// Large enough to run for far longer than the test waits
+		_, err := calc.FibonacciBigCtx(ctx, 50_000_000)
+		done <- err
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("FibonacciBigCtx() error = %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("FibonacciBigCtx() did not stop after cancellation")
+	}
+}
+
+func TestCalculatorFibonacciBigCtx_AlreadyDone(t *testing.T) {
+	calc := NewCalculator()
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := calc.FibonacciBigCtx(cancelled, 10); !errors.Is(err, context.Canceled) {
+		t.Errorf("FibonacciBigCtx(cancelled) error = %v, want %v", err, context.Canceled)
+	}
+
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	if _, err := calc.FibonacciBigCtx(expired, 10); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("FibonacciBigCtx(expired) error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestCalculatorFibonacci_Cached(t *testing.T) {
+	calc := NewCalculator()
+
+	first, err := calc.Fibonacci(40)
+	if err != nil {
+		t.Fatalf("Fibonacci(40) unexpected error: %v", err)
+	}
+	second, err := calc.Fibonacci(40)
+	if err != nil {
+		t.Fatalf("Fibonacci(40) second call unexpected error: %v", err)
+	}
+
+	if first != 102334155 || second != first {
+		t.Errorf("Fibonacci(40) = %d then %d, want 102334155 both times", first, second)
+	}
+	if cached, ok := calc.fibCache[40]; !ok || cached != first {
+		t.Errorf("fibCache[40] = %d (present %t), want %d", cached, ok, first)
+	}
+
+	// Smaller values are filled in along the way
+	if got, err := calc.Fibonacci(20); err != nil || got != 6765 {
+		t.Errorf("Fibonacci(20) = %d, %v; want 6765, nil", got, err)
+	}
+}
+
+func TestCalculatorFibonacci_ConcurrentCache(t *testing.T) {
+	calc := NewCalculator()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			want, _ := NewCalculator().Fibonacci(n)
+			if got, err := calc.Fibonacci(n); err != nil || got != want {
+				t.Errorf("Fibonacci(%d) = %d, %v; want %d, nil", n, got, err, want)
+			}
+		}(26 + i)
+	}
+	wg.Wait()
+}
+
+func BenchmarkCalculatorFibonacci_Cached(b *testing.B) {
+	calc := NewCalculator()
+	for i := 0; i < b.N; i++ {
+		for n := 0; n <= 46; n++ {
+			_, _ = calc.Fibonacci(n)
+		}
+	}
+}
+
+func BenchmarkCalculatorFibonacci_Uncached(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n <= 46; n++ {
+			_, _ = NewCalculator().Fibonacci(n)
+		}
+	}
+}
+
+func TestCalculatorAbs(t *testing.T) {
+	tests := []struct {
+		name     string
+		x        float64
+		expected float64
+		wantErr  string
+	}{
+		{name: "negative", x: -3.5, expected: 3.5},
+		{name: "positive", x: 3.5, expected: 3.5},
+		{name: "zero", x: 0, expected: 0},
+		{name: "negative_zero", x: math.Copysign(0, -1), expected: 0},
+		{name: "nan_input", x: math.NaN(), wantErr: "NaN values not allowed"},
+		{name: "inf_input", x: math.Inf(-1), wantErr: "infinite values not allowed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			result, err := calc.Abs(tt.x)
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Abs(%v) error = %v, want %q", tt.x, err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Abs(%v) unexpected error: %v", tt.x, err)
+			}
+			if result != tt.expected || math.Signbit(result) || calc.Result != tt.expected {
+				t.Errorf("Abs(%v) = %v (Result %v), want %v", tt.x, result, calc.Result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculatorNegate(t *testing.T) {
+	tests := []struct {
+		name     string
+		x        float64
+		expected float64
+		wantErr  string
+	}{
+		{name: "negative", x: -3.5, expected: 3.5},
+		{name: "positive", x: 3.5, expected: -3.5},
+		{name: "zero", x: 0, expected: 0},
+		{name: "negative_zero", x: math.Copysign(0, -1), expected: 0},
+		{name: "nan_input", x: math.NaN(), wantErr: "NaN values not allowed"},
+		{name: "inf_input", x: math.Inf(1), wantErr: "infinite values not allowed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			result, err := calc.Negate(tt.x)
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Negate(%v) error = %v, want %q", tt.x, err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Negate(%v) unexpected error: %v", tt.x, err)
+			}
+			if result != tt.expected || calc.Result != tt.expected {
+				t.Errorf("Negate(%v) = %v (Result %v), want %v", tt.x, result, calc.Result, tt.expected)
+			}
+			if tt.expected == 0 && math.Signbit(result) {
+				t.Errorf("Negate(%v) returned negative zero", tt.x)
+			}
+		})
+	}
+}
+
+func TestCalculatorAbsNegate_History(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Abs(-2); err != nil {
+		t.Fatalf("Abs(-2) unexpected error: %v", err)
+	}
+	if _, err := calc.Negate(0); err != nil {
+		t.Fatalf("Negate(0) unexpected error: %v", err)
+	}
+
+	want := []string{"abs(-2.00) = 2.00", "neg(0.00) = 0.00"}
+	if len(calc.History) != len(want) || calc.History[0] != want[0] || calc.History[1] != want[1] {
+		t.Errorf("History = %v, want %v", calc.History, want)
+	}
+}
+
+func TestCalculatorRound(t *testing.T) {
+	tests := []struct {
+		name     string
+		x        float64
+		places   int
+		expected float64
+		wantErr  string
+	}{
+		{name: "half_up_two_places", x: 2.345, places: 2, expected: 2.35},
+		{name: "below_half", x: 2.344, places: 2, expected: 2.34},
+		{name: "negative_half_away_from_zero", x: -2.345, places: 2, expected: -2.35},
+		{name: "zero_places", x: 2.5, places: 0, expected: 3},
+		{name: "negative_zero_places", x: -2.5, places: 0, expected: -3},
+		{name: "round_to_hundreds", x: 1234, places: -2, expected: 1200},
+		{name: "round_to_tens_half", x: 1235, places: -1, expected: 1240},
+		{name: "round_to_thousands", x: 1500, places: -3, expected: 2000},
+		{name: "excess_places", x: 1.5, places: 400, expected: 1.5},
+		{name: "zero_excess_places", x: 0, places: 309, expected: 0},
+		{name: "excess_negative_places", x: 1234, places: -400, expected: 0},
+		{name: "negative_excess_negative_places", x: -1234, places: -309, expected: 0},
+		{name: "nan_input", x: math.NaN(), places: 2, wantErr: "NaN values not allowed"},
+		{name: "inf_input", x: math.Inf(1), places: 2, wantErr: "infinite values not allowed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			result, err := calc.Round(tt.x, tt.places)
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Round(%v, %d) error = %v, want %q", tt.x, tt.places, err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Round(%v, %d) unexpected error: %v", tt.x, tt.places, err)
+			}
+			if result != tt.expected || calc.Result != tt.expected {
+				t.Errorf("Round(%v, %d) = %v (Result %v), want %v", tt.x, tt.places, result, calc.Result, tt.expected)
+			}
+			if math.Signbit(result) != math.Signbit(tt.x) {
+				t.Errorf("Round(%v, %d) = %v, want the sign of %v", tt.x, tt.places, result, tt.x)
+			}
+		})
+	}
+}
+
+func TestCalculatorFloorCeil(t *testing.T) {
+	tests := []struct {
+		name      string
+		x         float64
+		places    int
+		wantFloor float64
+		wantCeil  float64
+	}{
+		{name: "two_places", x: 2.345, places: 2, wantFloor: 2.34, wantCeil: 2.35},
+		{name: "exact_value", x: 0.29, places: 2, wantFloor: 0.29, wantCeil: 0.29},
+		{name: "negative", x: -2.345, places: 2, wantFloor: -2.35, wantCeil: -2.34},
+		{name: "zero_places", x: 7.1, places: 0, wantFloor: 7, wantCeil: 8},
+		{name: "negative_places", x: 1234, places: -2, wantFloor: 1200, wantCeil: 1300},
+		{name: "excess_negative_places", x: 5, places: -309, wantFloor: 0, wantCeil: 0},
+		{name: "excess_places", x: 0, places: 309, wantFloor: 0, wantCeil: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+
+			floor, err := calc.Floor(tt.x, tt.places)
+			if err != nil || floor != tt.wantFloor {
+				t.Errorf("Floor(%v, %d) = %v, %v; want %v, nil", tt.x, tt.places, floor, err, tt.wantFloor)
+			}
+
+			ceil, err := calc.Ceil(tt.x, tt.places)
+			if err != nil || ceil != tt.wantCeil {
+				t.Errorf("Ceil(%v, %d) = %v, %v; want %v, nil", tt.x, tt.places, ceil, err, tt.wantCeil)
+			}
+		})
+	}
+}
+
+func TestCalculatorRound_History(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Round(1234, -2); err != nil {
+		t.Fatalf("Round(1234, -2) unexpected error: %v", err)
+	}
+	if _, err := calc.Floor(2.37, 1); err != nil {
+		t.Fatalf("Floor(2.37, 1) unexpected error: %v", err)
+	}
+
+	want := []string{"round(1234.00, -2) = 1200.00", "floor(2.37, 1) = 2.30"}
+	if len(calc.History) != len(want) || calc.History[0] != want[0] || calc.History[1] != want[1] {
+		t.Errorf("History = %v, want %v", calc.History, want)
+	}
+}
+
+func TestCalculatorLn(t *testing.T) {
+	tests := []struct {
+		name     string
+		x        float64
+		expected float64
+		wantErr  string
+	}{
+		{name: "one", x: 1, expected: 0},
+		{name: "e", x: math.E, expected: 1},
+		{name: "fraction", x: 0.5, expected: -math.Ln2},
+		{name: "zero", x: 0, wantErr: "logarithm of non-positive number"},
+		{name: "negative", x: -1, wantErr: "logarithm of non-positive number"},
+		{name: "nan_input", x: math.NaN(), wantErr: "NaN values not allowed"},
+		{name: "inf_input", x: math.Inf(1), wantErr: "infinite values not allowed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			result, err := calc.Ln(tt.x)
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Ln(%v) error = %v, want %q", tt.x, err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Ln(%v) unexpected error: %v", tt.x, err)
+			}
+			if result != tt.expected || calc.Result != tt.expected {
+				t.Errorf("Ln(%v) = %v (Result %v), want %v", tt.x, result, calc.Result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculatorLog10(t *testing.T) {
+	tests := []struct {
+		name     string
+		x        float64
+		expected float64
+		wantErr  bool
+	}{
+		{name: "one", x: 1, expected: 0},
+		{name: "thousand", x: 1000, expected: 3},
+		{name: "hundredth", x: 0.01, expected: -2},
+		{name: "zero", x: 0, wantErr: true},
+		{name: "negative", x: -100, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewCalculator().Log10(tt.x)
+
+			if tt.wantErr {
+				if err == nil || err.Error() != "logarithm of non-positive number" {
+					t.Fatalf("Log10(%v) error = %v, want %q", tt.x, err, "logarithm of non-positive number")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Log10(%v) unexpected error: %v", tt.x, err)
+			}
+			if result != tt.expected {
+				t.Errorf("Log10(%v) = %v, want %v", tt.x, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculatorLogBase(t *testing.T) {
+	tests := []struct {
+		name     string
+		x, base  float64
+		expected float64
+		wantErr  string
+	}{
+		{name: "base_two", x: 8, base: 2, expected: 3},
+		{name: "base_three", x: 81, base: 3, expected: 4},
+		{name: "fractional_base", x: 4, base: 0.5, expected: -2},
+		{name: "one_in_any_base", x: 1, base: 7, expected: 0},
+		{name: "non_positive_x", x: 0, base: 2, wantErr: "logarithm of non-positive number"},
+		{name: "base_one", x: 8, base: 1, wantErr: "logarithm base must be positive and not equal to 1"},
+		{name: "base_zero", x: 8, base: 0, wantErr: "logarithm base must be positive and not equal to 1"},
+		{name: "negative_base", x: 8, base: -2, wantErr: "logarithm base must be positive and not equal to 1"},
+		{name: "nan_base", x: 8, base: math.NaN(), wantErr: "NaN values not allowed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewCalculator().LogBase(tt.x, tt.base)
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("LogBase(%v, %v) error = %v, want %q", tt.x, tt.base, err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("LogBase(%v, %v) unexpected error: %v", tt.x, tt.base, err)
+			}
+			if math.Abs(result-tt.expected) > 1e-12 {
+				t.Errorf("LogBase(%v, %v) = %v, want %v", tt.x, tt.base, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculatorLog_History(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Ln(1); err != nil {
+		t.Fatalf("Ln(1) unexpected error: %v", err)
+	}
+	if _, err := calc.Log10(1000); err != nil {
+		t.Fatalf("Log10(1000) unexpected error: %v", err)
+	}
+	if _, err := calc.LogBase(8, 2); err != nil {
+		t.Fatalf("LogBase(8, 2) unexpected error: %v", err)
+	}
+
+	want := []string{"ln(1.00) = 0.00", "log10(1000.00) = 3.00", "log(8.00, base 2.00) = 3.00"}
+	if len(calc.History) != len(want) {
+		t.Fatalf("History = %v, want %v", calc.History, want)
+	}
+	for i := range want {
+		if calc.History[i] != want[i] {
+			t.Errorf("History[%d] = %q, want %q", i, calc.History[i], want[i])
+		}
+	}
+}
+
+func TestCalculatorTrig_Radians(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       func(*Calculator, float64) (float64, error)
+		x        float64
+		expected float64
+		wantErr  bool
+	}{
+		{name: "sin_zero", fn: (*Calculator).Sin, x: 0, expected: 0},
+		{name: "sin_half_pi", fn: (*Calculator).Sin, x: math.Pi / 2, expected: 1},
+		{name: "sin_sixth_pi", fn: (*Calculator).Sin, x: math.Pi / 6, expected: 0.5},
+		{name: "cos_zero", fn: (*Calculator).Cos, x: 0, expected: 1},
+		{name: "cos_pi", fn: (*Calculator).Cos, x: math.Pi, expected: -1},
+		{name: "tan_quarter_pi", fn: (*Calculator).Tan, x: math.Pi / 4, expected: 1},
+		{name: "tan_negative_quarter_pi", fn: (*Calculator).Tan, x: -math.Pi / 4, expected: -1},
+		{name: "tan_half_pi_asymptote", fn: (*Calculator).Tan, x: math.Pi / 2, wantErr: true},
+		{name: "tan_three_half_pi_asymptote", fn: (*Calculator).Tan, x: 3 * math.Pi / 2, wantErr: true},
+		{name: "nan_input", fn: (*Calculator).Sin, x: math.NaN(), wantErr: true},
+		{name: "inf_input", fn: (*Calculator).Cos, x: math.Inf(1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			result, err := tt.fn(calc, tt.x)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("%s(%v) expected error but got %v", tt.name, tt.x, result)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("%s(%v) unexpected error: %v", tt.name, tt.x, err)
+			}
+			if math.Abs(result-tt.expected) > 1e-12 {
+				t.Errorf("%s(%v) = %v, want %v", tt.name, tt.x, result, tt.expected)
+			}
+		})
+	}
+
+	if got, _ := NewCalculator().Sin(math.Pi / 2); got != 1 {
+		t.Errorf("Sin(π/2) = %v, want exactly 1", got)
+	}
+}
+
+func TestCalculatorTrig_Degrees(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       func(*Calculator, float64) (float64, error)
+		x        float64
+		expected float64
+		wantErr  bool
+	}{
+		{name: "sin_90", fn: (*Calculator).Sin, x: 90, expected: 1},
+		{name: "sin_180", fn: (*Calculator).Sin, x: 180, expected: 0},
+		{name: "sin_270", fn: (*Calculator).Sin, x: 270, expected: -1},
+		{name: "sin_negative_90", fn: (*Calculator).Sin, x: -90, expected: -1},
+		{name: "sin_450", fn: (*Calculator).Sin, x: 450, expected: 1},
+		{name: "sin_30", fn: (*Calculator).Sin, x: 30, expected: 0.5},
+		{name: "cos_90", fn: (*Calculator).Cos, x: 90, expected: 0},
+		{name: "cos_60", fn: (*Calculator).Cos, x: 60, expected: 0.5},
+		{name: "cos_180", fn: (*Calculator).Cos, x: 180, expected: -1},
+		{name: "tan_45", fn: (*Calculator).Tan, x: 45, expected: 1},
+		{name: "tan_180", fn: (*Calculator).Tan, x: 180, expected: 0},
+		{name: "tan_90_asymptote", fn: (*Calculator).Tan, x: 90, wantErr: true},
+		{name: "tan_negative_270_asymptote", fn: (*Calculator).Tan, x: -270, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			calc.SetAngleMode(Degrees)
+			result, err := tt.fn(calc, tt.x)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("%s(%v°) expected error but got %v", tt.name, tt.x, result)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("%s(%v°) unexpected error: %v", tt.name, tt.x, err)
+			}
+			if math.Abs(result-tt.expected) > 1e-12 {
+				t.Errorf("%s(%v°) = %v, want %v", tt.name, tt.x, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculatorSetAngleMode(t *testing.T) {
+	calc := NewCalculator()
+	if calc.AngleMode != Radians {
+		t.Fatalf("default AngleMode = %v, want Radians", calc.AngleMode)
+	}
+
+	calc.SetAngleMode(Degrees)
+	if got, err := calc.Sin(90); err != nil || got != 1 {
+		t.Errorf("Sin(90) in degree mode = %v, %v; want exactly 1, nil", got, err)
+	}
+
+	calc.SetAngleMode(Radians)
+	if got, err := calc.Sin(90); err != nil || got != math.Sin(90) {
+		t.Errorf("Sin(90) in radian mode = %v, %v; want %v, nil", got, err, math.Sin(90))
+	}
+
+	if len(calc.History) != 2 || calc.History[0] != "sin(90.00) = 1.00" {
+		t.Errorf("History = %v, want first entry %q", calc.History, "sin(90.00) = 1.00")
+	}
+}
+
+func TestCalculatorSetPrecision(t *testing.T) {
+	calc := NewCalculator()
+	if calc.Precision != 2 {
+		t.Fatalf("NewCalculator().Precision = %d, want 2", calc.Precision)
+	}
+
+	if _, err := calc.Divide(10, 3); err != nil {
+		t.Fatalf("Divide(10, 3) unexpected error: %v", err)
+	}
+	if got := calc.FormatResult(); got != "3.33" {
+		t.Errorf("FormatResult() = %q, want %q", got, "3.33")
+	}
+
+	calc.SetPrecision(4)
+	if got := calc.FormatResult(); got != "3.3333" {
+		t.Errorf("FormatResult() after SetPrecision(4) = %q, want %q", got, "3.3333")
+	}
+	if _, err := calc.Divide(2, 3); err != nil {
+		t.Fatalf("Divide(2, 3) unexpected error: %v", err)
+	}
+	if _, err := calc.Eval("1 / 8"); err != nil {
+		t.Fatalf("Eval(1 / 8) unexpected error: %v", err)
+	}
+
+	calc.SetPrecision(-1)
+	if calc.Precision != 0 {
+		t.Errorf("Precision after SetPrecision(-1) = %d, want 0", calc.Precision)
+	}
+	if _, err := calc.Add(1.4, 1.4); err != nil {
+		t.Fatalf("Add(1.4, 1.4) unexpected error: %v", err)
+	}
+
+	want := []string{
+		"10.00 / 3.00 = 3.33",
+		"2.0000 / 3.0000 = 0.6667",
+		"1 / 8 = 0.1250",
+		"1 + 1 = 3",
+	}
+	history := calc.GetHistory()
+	if len(history) != len(want) {
+		t.Fatalf("GetHistory() = %q, want %q", history, want)
+	}
+	for i := range want {
+		if history[i] != want[i] {
+			t.Errorf("History[%d] = %q, want %q", i, history[i], want[i])
+		}
+	}
+}
+
+func TestCalculatorHistoryFormatter(t *testing.T) {
+	calc := NewCalculator()
+	calc.HistoryFormatter = func(op string, a, b, result float64) string {
+		return fmt.Sprintf("[%s] %g, %g -> %g", op, a, b, result)
+	}
+
+	ops := []func() (float64, error){
+		func() (float64, error) { return calc.Add(1.5, 2) },
+		func() (float64, error) { return calc.Subtract(9, 4) },
+		func() (float64, error) { return calc.Multiply(3, 4) },
+		func() (float64, error) { return calc.Divide(1, 8) },
+		func() (float64, error) { return calc.Power(2, 10) },
+		func() (float64, error) { return calc.Modulo(10, 3) },
+		func() (float64, error) { return calc.Percentage(200, 15) },
+		func() (float64, error) { return calc.PercentChange(50, 75) },
+		func() (float64, error) { return calc.LogBase(8, 2) },
+		func() (float64, error) { return calc.SquareRoot(16) },
+	}
+	for i, op := range ops {
+		if _, err := op(); err != nil {
+			t.Fatalf("operation %d unexpected error: %v", i, err)
+		}
+	}
+
+	want := []string{
+		"[+] 1.5, 2 -> 3.5",
+		"[-] 9, 4 -> 5",
+		"[*] 3, 4 -> 12",
+		"[/] 1, 8 -> 0.125",
+		"[^] 2, 10 -> 1024",
+		"[%] 10, 3 -> 1",
+		"[% of] 15, 200 -> 30",
+		"[->] 50, 75 -> 50",
+		"[log] 8, 2 -> 3",
+		// Single-operand operations keep their built-in format
+		"sqrt(16.00) = 4.00",
+	}
+	history := calc.GetHistory()
+	if len(history) != len(want) {
+		t.Fatalf("GetHistory() = %q, want %q", history, want)
+	}
+	for i := range want {
+		if history[i] != want[i] {
+			t.Errorf("History[%d] = %q, want %q", i, history[i], want[i])
+		}
+	}
+}
+
+func TestCalculatorHistoryFormatter_DefaultFormat(t *testing.T) {
+	calc := NewCalculator()
+	if calc.HistoryFormatter != nil {
+		t.Fatal("NewCalculator().HistoryFormatter is set, want nil")
+	}
+	if _, err := calc.Modulo(10, 3); err != nil {
+		t.Fatalf("Modulo(10, 3) unexpected error: %v", err)
+	}
+	if _, err := calc.Percentage(200, 15); err != nil {
+		t.Fatalf("Percentage(200, 15) unexpected error: %v", err)
+	}
+	if _, err := calc.PercentChange(50, 75); err != nil {
+		t.Fatalf("PercentChange(50, 75) unexpected error: %v", err)
+	}
+	if _, err := calc.LogBase(8, 2); err != nil {
+		t.Fatalf("LogBase(8, 2) unexpected error: %v", err)
+	}
+
+	want := []string{"10.00 % 3.00 = 1.00", "15.00% of 200.00 = 30.00", "50.00 -> 75.00 = 50.00%", "log(8.00, base 2.00) = 3.00"}
+	if got := calc.GetHistory(); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetHistory() = %q, want %q", got, want)
+	}
+}
+
+func TestCalculatorString(t *testing.T) {
+	calc := NewCalculator()
+	if got, want := calc.String(), "Calculator{result: 0.00, history: 0 entries}"; got != want {
+		t.Errorf("String() on empty calculator = %q, want %q", got, want)
+	}
+
+	if _, err := calc.Add(10, 5); err != nil {
+		t.Fatalf("Add(10, 5) unexpected error: %v", err)
+	}
+	if got, want := calc.String(), "Calculator{result: 15.00, history: 1 entry}"; got != want {
+		t.Errorf("String() after one operation = %q, want %q", got, want)
+	}
+
+	if _, err := calc.Multiply(3, 4); err != nil {
+		t.Fatalf("Multiply(3, 4) unexpected error: %v", err)
+	}
+	if _, err := calc.Add(10, 5); err != nil {
+		t.Fatalf("Add(10, 5) unexpected error: %v", err)
+	}
+	if got, want := fmt.Sprint(calc), "Calculator{result: 15.00, history: 3 entries}"; got != want {
+		t.Errorf("fmt.Sprint(calc) = %q, want %q", got, want)
+	}
+
+	calc.SetPrecision(0)
+	if got, want := calc.String(), "Calculator{result: 15, history: 3 entries}"; got != want {
+		t.Errorf("String() with precision 0 = %q, want %q", got, want)
+	}
+}
+
+func TestCalculatorFormatScientific(t *testing.T) {
+	tests := []struct {
+		name     string
+		x        float64
+		sigFigs  int
+		expected string
+		wantErr  bool
+	}{
+		{name: "three_sig_figs", x: 12345.678, sigFigs: 3, expected: "1.23e+04"},
+		{name: "rounds_up", x: 12355, sigFigs: 3, expected: "1.24e+04"},
+		{name: "one_sig_fig", x: 12345.678, sigFigs: 1, expected: "1e+04"},
+		{name: "small_magnitude", x: 0.000123456, sigFigs: 4, expected: "1.235e-04"},
+		{name: "negative", x: -6.02214076e23, sigFigs: 5, expected: "-6.0221e+23"},
+		{name: "zero", x: 0, sigFigs: 2, expected: "0.0e+00"},
+		{name: "nan", x: math.NaN(), sigFigs: 3, wantErr: true},
+		{name: "infinite", x: math.Inf(-1), sigFigs: 3, wantErr: true},
+		{name: "zero_sig_figs", x: 1, sigFigs: 0, wantErr: true},
+	}
+
+	calc := NewCalculator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := calc.FormatScientific(tt.x, tt.sigFigs)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("FormatScientific(%v, %d) = %q, want error", tt.x, tt.sigFigs, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FormatScientific(%v, %d) unexpected error: %v", tt.x, tt.sigFigs, err)
+			}
+			if got != tt.expected {
+				t.Errorf("FormatScientific(%v, %d) = %q, want %q", tt.x, tt.sigFigs, got, tt.expected)
+			}
+		})
+	}
+
+	if len(calc.History) != 0 {
+		t.Errorf("History = %v, want empty", calc.History)
+	}
+}
+
+func TestCalculatorResultAsHexAndBinary(t *testing.T) {
+	tests := []struct {
+		name       string
+		result     float64
+		wantHex    string
+		wantBinary string
+	}{
+		{name: "zero", result: 0, wantHex: "0", wantBinary: "0"},
+		{name: "positive", result: 255, wantHex: "ff", wantBinary: "11111111"},
+		{name: "truncates_fraction", result: 10.9, wantHex: "a", wantBinary: "1010"},
+		{name: "negative_one", result: -1, wantHex: "ffffffffffffffff", wantBinary: strings.Repeat("1", 64)},
+		{name: "negative", result: -256.7, wantHex: "ffffffffffffff00", wantBinary: strings.Repeat("1", 56) + "00000000"},
+		{name: "saturates_high", result: 1e30, wantHex: "7fffffffffffffff", wantBinary: strings.Repeat("1", 63)},
+		{name: "saturates_low", result: -1e30, wantHex: "8000000000000000", wantBinary: "1" + strings.Repeat("0", 63)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			calc.Result = tt.result
+			if got := calc.ResultAsHex(); got != tt.wantHex {
+				t.Errorf("ResultAsHex() with Result %v = %q, want %q", tt.result, got, tt.wantHex)
+			}
+			if got := calc.ResultAsBinary(); got != tt.wantBinary {
+				t.Errorf("ResultAsBinary() with Result %v = %q, want %q", tt.result, got, tt.wantBinary)
+			}
+		})
+	}
+}
+
+func TestCalculatorSetHistoryWriter(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Add(1, 1); err != nil {
+		t.Fatalf("Add(1, 1) unexpected error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	calc.SetHistoryWriter(&buf)
+	if buf.Len() != 0 {
+		t.Errorf("writer received existing history %q, want nothing", buf.String())
+	}
+
+	if _, err := calc.Add(10, 5); err != nil {
+		t.Fatalf("Add(10, 5) unexpected error: %v", err)
+	}
+	if _, err := calc.Multiply(3, 4); err != nil {
+		t.Fatalf("Multiply(3, 4) unexpected error: %v", err)
+	}
+	if _, err := calc.SquareRoot(16); err != nil {
+		t.Fatalf("SquareRoot(16) unexpected error: %v", err)
+	}
+	if _, err := calc.Divide(1, 0); err == nil {
+		t.Fatal("Divide(1, 0) expected error")
+	}
+
+	want := "10.00 + 5.00 = 15.00\n3.00 * 4.00 = 12.00\nsqrt(16.00) = 4.00\n"
+	if got := buf.String(); got != want {
+		t.Errorf("writer contents = %q, want %q", got, want)
+	}
+
+	calc.SetHistoryWriter(nil)
+	if _, err := calc.Add(2, 2); err != nil {
+		t.Fatalf("Add(2, 2) unexpected error: %v", err)
+	}
+	if got := buf.String(); got != want {
+		t.Errorf("writer contents after SetHistoryWriter(nil) = %q, want %q", got, want)
+	}
+	if got := len(calc.GetHistory()); got != 5 {
+		t.Errorf("len(GetHistory()) = %d, want 5", got)
+	}
+}
+
+func TestCalculatorReset(t *testing.T) {
+	calc := NewCalculator()
+	calc.SetMaxHistory(5)
+	calc.SetAngleMode(Degrees)
+	calc.SetPrecision(4)
+	calc.HistoryFormatter = func(op string, a, b, result float64) string { return op }
+	var log bytes.Buffer
+	calc.SetHistoryWriter(&log)
+
+	if _, err := calc.Add(10, 5); err != nil {
+		t.Fatalf("Add(10, 5) unexpected error: %v", err)
+	}
+	if _, err := calc.Sin(90); err != nil {
+		t.Fatalf("Sin(90) unexpected error: %v", err)
+	}
+	if _, err := calc.Fibonacci(20); err != nil {
+		t.Fatalf("Fibonacci(20) unexpected error: %v", err)
+	}
+	calc.MemoryAdd()
+	calc.Accumulate(3)
+	if err := calc.Undo(); err != nil {
+		t.Fatalf("Undo() unexpected error: %v", err)
+	}
+
+	calc.Reset()
+
+	if !reflect.DeepEqual(calc, NewCalculator()) {
+		t.Errorf("after Reset() calculator = %+v, want the NewCalculator state", calc)
+	}
+	if err := calc.Undo(); err == nil {
+		t.Error("Undo() after Reset() expected error")
+	}
+	if err := calc.Redo(); err == nil {
+		t.Error("Redo() after Reset() expected error")
+	}
+	if got := calc.MemoryRecall(); got != 0 {
+		t.Errorf("MemoryRecall() after Reset() = %v, want 0", got)
+	}
+	if got := calc.RunningMean(); got != 0 {
+		t.Errorf("RunningMean() after Reset() = %v, want 0", got)
+	}
+
+	// The calculator is fully usable afterwards
+	if _, err := calc.Add(1, 2); err != nil {
+		t.Fatalf("Add(1, 2) after Reset() unexpected error: %v", err)
+	}
+	if got := calc.GetHistory(); len(got) != 1 || got[0] != "1.00 + 2.00 = 3.00" {
+		t.Errorf("GetHistory() after Reset() and Add = %q", got)
+	}
+	if strings.Contains(log.String(), "1.00 + 2.00") {
+		t.Errorf("history writer received %q after Reset()", log.String())
+	}
+}
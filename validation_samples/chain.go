@@ -0,0 +1,66 @@
+package main
+
+// ChainOp is a running value for a fluent sequence of calculations started
+// with Calculator.Chain. Each step applies the matching Calculator method to
+// the running value, so steps are recorded in History as usual. The first
+// error stops the chain: later steps are skipped and Result reports it.
+type ChainOp struct {
+	calc  *Calculator
+	value float64
+	err   error
+}
+
+// Chain starts a fluent calculation with value as the running value, as in
+// c.Chain(10).Add(5).Multiply(2).Result()
+func (c *Calculator) Chain(value float64) *ChainOp {
+	return &ChainOp{calc: c, value: value}
+}
+
+// Add adds x to the running value
+func (op *ChainOp) Add(x float64) *ChainOp {
+	return op.apply(op.calc.Add, x)
+}
+
+// Subtract subtracts x from the running value
+func (op *ChainOp) Subtract(x float64) *ChainOp {
+	return op.apply(op.calc.Subtract, x)
+}
+
+// Multiply multiplies the running value by x
+func (op *ChainOp) Multiply(x float64) *ChainOp {
+	return op.apply(op.calc.Multiply, x)
+}
+
+// Divide divides the running value by x
+func (op *ChainOp) Divide(x float64) *ChainOp {
+	return op.apply(op.calc.Divide, x)
+}
+
+// Power raises the running value to the power x
+func (op *ChainOp) Power(x float64) *ChainOp {
+	return op.apply(op.calc.Power, x)
+}
+
+// Modulo replaces the running value with its remainder after division by x
+func (op *ChainOp) Modulo(x float64) *ChainOp {
+	return op.apply(op.calc.Modulo, x)
+}
+
+// Result returns the running value, or the error from the first step that
+// failed
+func (op *ChainOp) Result() (float64, error) {
+	if op.err != nil {
+		return 0, op.err
+	}
+	return op.value, nil
+}
+
+// apply runs fn on the running value and x unless an earlier step failed
+func (op *ChainOp) apply(fn func(a, b float64) (float64, error), x float64) *ChainOp {
+	if op.err != nil {
+		return op
+	}
+
+	op.value, op.err = fn(op.value, x)
+	return op
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestCalculatorChain(t *testing.T) {
+	calc := NewCalculator()
+
+	result, err := calc.Chain(10).Add(5).Multiply(2).Subtract(6).Divide(4).Power(2).Modulo(10).Result()
+	if err != nil {
+		t.Fatalf("Chain() unexpected error: %v", err)
+	}
+	// ((10 + 5) * 2 - 6) / 4 = 6, 6^2 = 36, 36 % 10 = 6
+	if result != 6 {
+		t.Errorf("Chain() = %v, want 6", result)
+	}
+
+	if got := calc.CurrentResult(); got != 6 {
+		t.Errorf("CurrentResult() after Chain() = %v, want 6", got)
+	}
+	if got := len(calc.GetHistory()); got != 6 {
+		t.Errorf("len(GetHistory()) after Chain() = %d, want 6", got)
+	}
+}
+
+func TestCalculatorChain_NoSteps(t *testing.T) {
+	result, err := NewCalculator().Chain(3.5).Result()
+	if err != nil || result != 3.5 {
+		t.Errorf("Chain(3.5).Result() = %v, %v, want 3.5, nil", result, err)
+	}
+}
+
+func TestCalculatorChain_DivideByZero(t *testing.T) {
+	calc := NewCalculator()
+
+	result, err := calc.Chain(10).Add(5).Divide(0).Multiply(2).Add(1).Result()
+	if !errors.Is(err, ErrDivByZero) {
+		t.Fatalf("Chain() error = %v, want %v", err, ErrDivByZero)
+	}
+	if result != 0 {
+		t.Errorf("Chain() result = %v, want 0 on error", result)
+	}
+
+	// Only the step before the failure ran
+	history := calc.GetHistory()
+	if len(history) != 1 || history[0] != "10.00 + 5.00 = 15.00" {
+		t.Errorf("GetHistory() = %q, want only the Add step", history)
+	}
+	if got := calc.CurrentResult(); got != 15 {
+		t.Errorf("CurrentResult() = %v, want 15", got)
+	}
+}
+
+func TestCalculatorChain_InvalidStart(t *testing.T) {
+	_, err := NewCalculator().Chain(math.NaN()).Add(1).Result()
+	if !errors.Is(err, ErrNaN) {
+		t.Errorf("Chain(NaN).Add(1) error = %v, want %v", err, ErrNaN)
+	}
+}
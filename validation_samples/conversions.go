@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+)
+
+// LengthUnit identifies a unit of length for ConvertLength
+type LengthUnit int
+
+const (
+	// Meters is the SI unit of length
+	Meters LengthUnit = iota
+	// Kilometers are 1000 meters
+	Kilometers
+	// Miles are international miles of 1609.344 meters
+	Miles
+	// Feet are international feet of 0.3048 meters
+	Feet
+	// Inches are 0.0254 meters
+	Inches
+)
+
+// metersPerUnit gives the length of each unit in meters, the canonical unit
+// ConvertLength converts through
+var metersPerUnit = map[LengthUnit]float64{
+	Meters:     1,
+	Kilometers: 1000,
+	Miles:      1609.344,
+	Feet:       0.3048,
+	Inches:     0.0254,
+}
+
+// absoluteZeroCelsius is 0 K expressed in degrees Celsius
+const absoluteZeroCelsius = -273.15
+
+// CelsiusToFahrenheit converts a temperature from Celsius to Fahrenheit
+func CelsiusToFahrenheit(c float64) float64 {
+	return c*9/5 + 32
+}
+
+// FahrenheitToCelsius converts a temperature from Fahrenheit to Celsius
+func FahrenheitToCelsius(f float64) float64 {
+	return (f - 32) * 5 / 9
+}
+
+// CelsiusToKelvin converts a temperature from Celsius to Kelvin
+func CelsiusToKelvin(c float64) float64 {
+	return c - absoluteZeroCelsius
+}
+
+// KelvinToCelsius converts a temperature from Kelvin to Celsius, rejecting
+// negative values since nothing is colder than absolute zero
+func KelvinToCelsius(k float64) (float64, error) {
+	if k < 0 {
+		return 0, fmt.Errorf("temperature below absolute zero: %w", ErrNegative)
+	}
+	return k + absoluteZeroCelsius, nil
+}
+
+// ConvertLength converts value from one unit of length to another
+func ConvertLength(value float64, from, to LengthUnit) (float64, error) {
+	fromMeters, ok := metersPerUnit[from]
+	if !ok {
+		return 0, fmt.Errorf("unknown length unit %d", from)
+	}
+
+	toMeters, ok := metersPerUnit[to]
+	if !ok {
+		return 0, fmt.Errorf("unknown length unit %d", to)
+	}
+
+	if from == to {
+		return value, nil
+	}
+	return value * fromMeters / toMeters, nil
+}
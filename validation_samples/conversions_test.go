@@ -0,0 +1,105 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+const conversionEpsilon = 1e-9
+
+func TestTemperatureConversions(t *testing.T) {
+	tests := []struct {
+		name       string
+		celsius    float64
+		fahrenheit float64
+		kelvin     float64
+	}{
+		{name: "water_freezes", celsius: 0, fahrenheit: 32, kelvin: 273.15},
+		{name: "water_boils", celsius: 100, fahrenheit: 212, kelvin: 373.15},
+		{name: "scales_meet", celsius: -40, fahrenheit: -40, kelvin: 233.15},
+		{name: "absolute_zero", celsius: -273.15, fahrenheit: -459.67, kelvin: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CelsiusToFahrenheit(tt.celsius); math.Abs(got-tt.fahrenheit) > conversionEpsilon {
+				t.Errorf("CelsiusToFahrenheit(%v) = %v, want %v", tt.celsius, got, tt.fahrenheit)
+			}
+			if got := FahrenheitToCelsius(tt.fahrenheit); math.Abs(got-tt.celsius) > conversionEpsilon {
+				t.Errorf("FahrenheitToCelsius(%v) = %v, want %v", tt.fahrenheit, got, tt.celsius)
+			}
+			if got := CelsiusToKelvin(tt.celsius); math.Abs(got-tt.kelvin) > conversionEpsilon {
+				t.Errorf("CelsiusToKelvin(%v) = %v, want %v", tt.celsius, got, tt.kelvin)
+			}
+
+			got, err := KelvinToCelsius(tt.kelvin)
+			if err != nil {
+				t.Fatalf("KelvinToCelsius(%v) unexpected error: %v", tt.kelvin, err)
+			}
+			if math.Abs(got-tt.celsius) > conversionEpsilon {
+				t.Errorf("KelvinToCelsius(%v) = %v, want %v", tt.kelvin, got, tt.celsius)
+			}
+		})
+	}
+}
+
+func TestKelvinToCelsius_BelowAbsoluteZero(t *testing.T) {
+	if _, err := KelvinToCelsius(-0.01); !errors.Is(err, ErrNegative) {
+		t.Errorf("KelvinToCelsius(-0.01) error = %v, want %v", err, ErrNegative)
+	}
+}
+
+func TestConvertLength(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    float64
+		from, to LengthUnit
+		expected float64
+		wantErr  bool
+	}{
+		{name: "meters_to_feet", value: 1, from: Meters, to: Feet, expected: 3.280839895013123},
+		{name: "feet_to_inches", value: 1, from: Feet, to: Inches, expected: 12},
+		{name: "miles_to_feet", value: 1, from: Miles, to: Feet, expected: 5280},
+		{name: "kilometers_to_meters", value: 2.5, from: Kilometers, to: Meters, expected: 2500},
+		{name: "miles_to_kilometers", value: 1, from: Miles, to: Kilometers, expected: 1.609344},
+		{name: "same_unit", value: 42, from: Inches, to: Inches, expected: 42},
+		{name: "negative_value", value: -10, from: Meters, to: Kilometers, expected: -0.01},
+		{name: "unknown_from", value: 1, from: LengthUnit(99), to: Meters, wantErr: true},
+		{name: "unknown_to", value: 1, from: Meters, to: LengthUnit(-1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ConvertLength(tt.value, tt.from, tt.to)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ConvertLength(%v, %d, %d) = %v, want error", tt.value, tt.from, tt.to, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ConvertLength(%v, %d, %d) unexpected error: %v", tt.value, tt.from, tt.to, err)
+			}
+			if math.Abs(result-tt.expected) > conversionEpsilon {
+				t.Errorf("ConvertLength(%v, %d, %d) = %v, want %v", tt.value, tt.from, tt.to, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConvertLength_RoundTrip(t *testing.T) {
+	for _, miles := range []float64{0, 1, 26.2, 1234.5} {
+		km, err := ConvertLength(miles, Miles, Kilometers)
+		if err != nil {
+			t.Fatalf("ConvertLength(%v, Miles, Kilometers) unexpected error: %v", miles, err)
+		}
+		back, err := ConvertLength(km, Kilometers, Miles)
+		if err != nil {
+			t.Fatalf("ConvertLength(%v, Kilometers, Miles) unexpected error: %v", km, err)
+		}
+		if math.Abs(back-miles) > conversionEpsilon {
+			t.Errorf("miles -> km -> miles: %v -> %v -> %v", miles, km, back)
+		}
+	}
+}
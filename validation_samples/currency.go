@@ -0,0 +1,226 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// currencyAmountRegex matches an unsigned amount that is either ungrouped
+// ("1234.56") or grouped in threes with commas ("1,234.56")
+var currencyAmountRegex = regexp.MustCompile(`^(\d{1,3}(,\d{3})+|\d+)(\.\d+)?$`)
+
+// currencyInfo describes how amounts in a currency are written
+type currencyInfo struct {
+	symbol   string
+	decimals int
+}
+
+// currencies maps ISO 4217 codes to their symbol and minor-unit digits
+var currencies = map[string]currencyInfo{
+	"USD": {symbol: "$", decimals: 2},
+	"EUR": {symbol: "€", decimals: 2},
+	"GBP": {symbol: "£", decimals: 2},
+	"JPY": {symbol: "¥", decimals: 0},
+	"CHF": {symbol: "CHF", decimals: 2},
+	"CAD": {symbol: "CA$", decimals: 2},
+	"AUD": {symbol: "A$", decimals: 2},
+	"CNY": {symbol: "CN¥", decimals: 2},
+	"INR": {symbol: "₹", decimals: 2},
+	"KRW": {symbol: "₩", decimals: 0},
+}
+
+// localeInfo describes a locale's number and currency conventions
+type localeInfo struct {
+	decimalSep string
+	groupSep   string
+	// symbolAfter places the symbol after the number, separated by a
+	// no-break space, as in "1.234,56 €"
+	symbolAfter bool
+}
+
+// locales maps BCP 47 tags to their currency formatting conventions
+var locales = map[string]localeInfo{
+	"en-US": {decimalSep: ".", groupSep: ","},
+	"en-GB": {decimalSep: ".", groupSep: ","},
+	"ja-JP": {decimalSep: ".", groupSep: ","},
+	"zh-CN": {decimalSep: ".", groupSep: ","},
+	"de-DE": {decimalSep: ",", groupSep: ".", symbolAfter: true},
+	"es-ES": {decimalSep: ",", groupSep: ".", symbolAfter: true},
+	"it-IT": {decimalSep: ",", groupSep: ".", symbolAfter: true},
+	"fr-FR": {decimalSep: ",", groupSep: " ", symbolAfter: true},
+	"de-CH": {decimalSep: ".", groupSep: "’"},
+}
+
+// FormatCurrencyLocale formats amount in the given ISO 4217 currency using
+// the conventions of a BCP 47 locale, for example "1.234,56 €" for EUR in
+// de-DE or "¥1,235" for JPY in ja-JP. Amounts are rounded to the currency's
+// minor unit. Only the bundled currencies and locales are supported.
+func FormatCurrencyLocale(amount float64, currencyCode string, locale string) (string, error) {
+	if math.IsNaN(amount) {
+		return "", ErrNaN
+	}
+
+	if math.IsInf(amount, 0) {
+		return "", ErrInfinite
+	}
+
+	cur, ok := currencies[strings.ToUpper(currencyCode)]
+	if !ok {
+		return "", fmt.Errorf("unknown currency code %q", currencyCode)
+	}
+
+	loc, ok := locales[canonicalLocale(locale)]
+	if !ok {
+		return "", fmt.Errorf("unsupported locale %q", locale)
+	}
+
+	number := formatGrouped(math.Abs(amount), cur.decimals, loc.groupSep, loc.decimalSep)
+	sign := ""
+	if isNegativeAmount(amount, cur.decimals) {
+		sign = "-"
+	}
+
+	if loc.symbolAfter {
+		return sign + number + " " + cur.symbol, nil
+	}
+	return sign + cur.symbol + number, nil
+}
+
+// FormatCurrencyGrouped formats a number as US dollars with thousands
+// separators, such as "$1,234,567.89" or "-$1,234,567.89"
+func FormatCurrencyGrouped(amount float64) string {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return "Invalid amount"
+	}
+
+	number := formatGrouped(math.Abs(amount), 2, ",", ".")
+	if isNegativeAmount(amount, 2) {
+		return "-$" + number
+	}
+	return "$" + number
+}
+
+// FormatCurrencyAccounting formats a number like FormatCurrencyGrouped but
+// wraps negatives in parentheses, so -1234.5 renders as "($1,234.50)"
+func FormatCurrencyAccounting(amount float64) string {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return "Invalid amount"
+	}
+
+	number := "$" + formatGrouped(math.Abs(amount), 2, ",", ".")
+	if isNegativeAmount(amount, 2) {
+		return "(" + number + ")"
+	}
+	return number
+}
+
+// RoundingMode selects how FormatCurrencyRounded rounds to whole cents
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds halves away from zero (2.125 -> 2.13, -2.125 -> -2.13)
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds halves to the nearest even cent (2.125 -> 2.12)
+	RoundHalfEven
+	// RoundFloor rounds toward negative infinity
+	RoundFloor
+	// RoundCeil rounds toward positive infinity
+	RoundCeil
+)
+
+// FormatCurrencyRounded formats a number like FormatCurrencyGrouped after
+// rounding it to cents with an explicit rounding mode. Halves are judged on
+// the decimal value as written, so 2.125 counts as a half even though its
+// binary representation is slightly below it.
+func FormatCurrencyRounded(amount float64, mode RoundingMode) string {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return "Invalid amount"
+	}
+
+	var round func(float64) float64
+	switch mode {
+	case RoundHalfUp:
+		round = math.Round
+	case RoundHalfEven:
+		round = math.RoundToEven
+	case RoundFloor:
+		round = math.Floor
+	case RoundCeil:
+		round = math.Ceil
+	default:
+		return "Invalid rounding mode"
+	}
+
+	return FormatCurrencyGrouped(roundPlaces(amount, 2, round))
+}
+
+// ParseCurrency parses a US dollar amount such as "$1,234.56", "-$5.00",
+// "$-5.00" or "1234.56" back into a number. It accepts the output of
+// FormatCurrency and FormatCurrencyGrouped.
+func ParseCurrency(s string) (float64, error) {
+	rest := strings.TrimSpace(s)
+
+	negative := false
+	if after, ok := strings.CutPrefix(rest, "-"); ok {
+		negative = true
+		rest = after
+	}
+	rest = strings.TrimPrefix(rest, "$")
+	if after, ok := strings.CutPrefix(rest, "-"); ok && !negative {
+		negative = true
+		rest = after
+	}
+
+	if !currencyAmountRegex.MatchString(rest) {
+		return 0, fmt.Errorf("invalid currency amount %q", s)
+	}
+
+	amount, err := strconv.ParseFloat(strings.ReplaceAll(rest, ",", ""), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid currency amount %q: %w", s, err)
+	}
+
+	if negative {
+		amount = -amount
+	}
+	return amount, nil
+}
+
+// isNegativeAmount reports whether amount is still negative once rounded to
+// the given number of decimals, so tiny negatives don't render as "-$0.00"
+func isNegativeAmount(amount float64, decimals int) bool {
+	return amount < 0 && math.Round(-amount*math.Pow10(decimals)) != 0
+}
+
+// canonicalLocale normalizes a locale tag such as "de_de" to "de-DE"
+func canonicalLocale(locale string) string {
+	lang, region, found := strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+	if !found {
+		return strings.ToLower(lang)
+	}
+	return strings.ToLower(lang) + "-" + strings.ToUpper(region)
+}
+
+// formatGrouped formats a non-negative amount with the given number of
+// decimals, inserting groupSep between each group of three integer digits
+func formatGrouped(amount float64, decimals int, groupSep, decimalSep string) string {
+	digits := fmt.Sprintf("%.*f", decimals, amount)
+	intPart, fracPart, _ := strings.Cut(digits, ".")
+
+	var b strings.Builder
+	for i, d := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteString(groupSep)
+		}
+		b.WriteRune(d)
+	}
+
+	if fracPart != "" {
+		b.WriteString(decimalSep)
+		b.WriteString(fracPart)
+	}
+	return b.String()
+}
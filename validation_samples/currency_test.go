@@ -0,0 +1,220 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestFormatCurrencyLocale(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		currency string
+		locale   string
+		expected string
+	}{
+		{name: "usd_en_us", amount: 1234.56, currency: "USD", locale: "en-US", expected: "$1,234.56"},
+		{name: "usd_negative", amount: -1234.56, currency: "USD", locale: "en-US", expected: "-$1,234.56"},
+		{name: "usd_millions", amount: 1234567.891, currency: "USD", locale: "en-US", expected: "$1,234,567.89"},
+		{name: "usd_small", amount: 0.5, currency: "usd", locale: "en_us", expected: "$0.50"},
+		{name: "eur_de_de", amount: 1234.56, currency: "EUR", locale: "de-DE", expected: "1.234,56 €"},
+		{name: "eur_de_de_negative", amount: -1234.56, currency: "EUR", locale: "de-DE", expected: "-1.234,56 €"},
+		{name: "eur_fr_fr", amount: 1234.56, currency: "EUR", locale: "fr-FR", expected: "1 234,56 €"},
+		{name: "eur_en_us", amount: 1234.56, currency: "EUR", locale: "en-US", expected: "€1,234.56"},
+		{name: "jpy_ja_jp", amount: 1234.56, currency: "JPY", locale: "ja-JP", expected: "¥1,235"},
+		{name: "jpy_small", amount: 999, currency: "JPY", locale: "ja-JP", expected: "¥999"},
+		{name: "gbp_en_gb", amount: 1000000, currency: "GBP", locale: "en-GB", expected: "£1,000,000.00"},
+		{name: "rounds_to_zero", amount: -0.001, currency: "USD", locale: "en-US", expected: "$0.00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := FormatCurrencyLocale(tt.amount, tt.currency, tt.locale)
+			if err != nil {
+				t.Fatalf("FormatCurrencyLocale(%v, %q, %q) unexpected error: %v", tt.amount, tt.currency, tt.locale, err)
+			}
+			if result != tt.expected {
+				t.Errorf("FormatCurrencyLocale(%v, %q, %q) = %q, want %q", tt.amount, tt.currency, tt.locale, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatCurrencyLocale_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		currency string
+		locale   string
+		wantErr  string
+	}{
+		{name: "unknown_currency", currency: "XYZ", locale: "en-US", wantErr: `unknown currency code "XYZ"`},
+		{name: "empty_currency", currency: "", locale: "en-US", wantErr: `unknown currency code ""`},
+		{name: "unknown_locale", currency: "USD", locale: "xx-YY", wantErr: `unsupported locale "xx-YY"`},
+		{name: "language_only_locale", currency: "USD", locale: "en", wantErr: `unsupported locale "en"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := FormatCurrencyLocale(10, tt.currency, tt.locale)
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("FormatCurrencyLocale(10, %q, %q) error = %v, want %q", tt.currency, tt.locale, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFormatCurrencyLocale_InvalidAmount(t *testing.T) {
+	if _, err := FormatCurrencyLocale(math.NaN(), "USD", "en-US"); !errors.Is(err, ErrNaN) {
+		t.Errorf("FormatCurrencyLocale(NaN) error = %v, want %v", err, ErrNaN)
+	}
+	if _, err := FormatCurrencyLocale(math.Inf(1), "USD", "en-US"); !errors.Is(err, ErrInfinite) {
+		t.Errorf("FormatCurrencyLocale(+Inf) error = %v, want %v", err, ErrInfinite)
+	}
+}
+
+func TestFormatCurrencyGrouped(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		expected string
+	}{
+		{name: "millions", amount: 1234567.89, expected: "$1,234,567.89"},
+		{name: "negative_millions", amount: -1234567.89, expected: "-$1,234,567.89"},
+		{name: "exact_thousand", amount: 1000, expected: "$1,000.00"},
+		{name: "below_thousand", amount: 999.99, expected: "$999.99"},
+		{name: "billions", amount: 9876543210.5, expected: "$9,876,543,210.50"},
+		{name: "sub_dollar", amount: 0.99, expected: "$0.99"},
+		{name: "negative_sub_dollar", amount: -0.5, expected: "-$0.50"},
+		{name: "zero", amount: 0, expected: "$0.00"},
+		{name: "negative_rounds_to_zero", amount: -0.004, expected: "$0.00"},
+		{name: "rounds_up_into_new_group", amount: 999999.999, expected: "$1,000,000.00"},
+		{name: "nan", amount: math.NaN(), expected: "Invalid amount"},
+		{name: "inf", amount: math.Inf(-1), expected: "Invalid amount"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatCurrencyGrouped(tt.amount); got != tt.expected {
+				t.Errorf("FormatCurrencyGrouped(%v) = %q, want %q", tt.amount, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatCurrencyRounded(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		mode     RoundingMode
+		expected string
+	}{
+		{name: "half_up_2125", amount: 2.125, mode: RoundHalfUp, expected: "$2.13"},
+		{name: "half_up_2135", amount: 2.135, mode: RoundHalfUp, expected: "$2.14"},
+		{name: "half_up_negative", amount: -2.125, mode: RoundHalfUp, expected: "-$2.13"},
+		{name: "half_even_2125", amount: 2.125, mode: RoundHalfEven, expected: "$2.12"},
+		{name: "half_even_2135", amount: 2.135, mode: RoundHalfEven, expected: "$2.14"},
+		{name: "half_even_negative", amount: -2.125, mode: RoundHalfEven, expected: "-$2.12"},
+		{name: "half_even_not_a_half", amount: 2.1251, mode: RoundHalfEven, expected: "$2.13"},
+		{name: "floor_2125", amount: 2.125, mode: RoundFloor, expected: "$2.12"},
+		{name: "floor_2135", amount: 2.135, mode: RoundFloor, expected: "$2.13"},
+		{name: "floor_negative", amount: -2.121, mode: RoundFloor, expected: "-$2.13"},
+		{name: "ceil_2125", amount: 2.125, mode: RoundCeil, expected: "$2.13"},
+		{name: "ceil_2135", amount: 2.135, mode: RoundCeil, expected: "$2.14"},
+		{name: "ceil_negative", amount: -2.129, mode: RoundCeil, expected: "-$2.12"},
+		{name: "ceil_exact_cents", amount: 0.29, mode: RoundCeil, expected: "$0.29"},
+		{name: "grouped_output", amount: 1234567.895, mode: RoundHalfUp, expected: "$1,234,567.90"},
+		{name: "nan", amount: math.NaN(), mode: RoundHalfUp, expected: "Invalid amount"},
+		{name: "unknown_mode", amount: 1, mode: RoundingMode(99), expected: "Invalid rounding mode"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatCurrencyRounded(tt.amount, tt.mode); got != tt.expected {
+				t.Errorf("FormatCurrencyRounded(%v, %d) = %q, want %q", tt.amount, tt.mode, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseCurrency(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected float64
+		wantErr  bool
+	}{
+		{name: "grouped", input: "$1,234.56", expected: 1234.56},
+		{name: "grouped_millions", input: "$1,234,567.89", expected: 1234567.89},
+		{name: "ungrouped", input: "1234.56", expected: 1234.56},
+		{name: "ungrouped_with_symbol", input: "$1234.56", expected: 1234.56},
+		{name: "whole_dollars", input: "$42", expected: 42},
+		{name: "negative_before_symbol", input: "-$1,234.50", expected: -1234.5},
+		{name: "negative_after_symbol", input: "$-5.00", expected: -5},
+		{name: "surrounding_space", input: "  $7.25 ", expected: 7.25},
+		{name: "invalid_text", input: "twelve dollars", wantErr: true},
+		{name: "empty", input: "", wantErr: true},
+		{name: "symbol_only", input: "$", wantErr: true},
+		{name: "bad_grouping", input: "$1,23,456.00", wantErr: true},
+		{name: "double_sign", input: "-$-5.00", wantErr: true},
+		{name: "trailing_dot", input: "$5.", wantErr: true},
+		{name: "infinity", input: "$Inf", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseCurrency(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseCurrency(%q) = %v, want error", tt.input, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseCurrency(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("ParseCurrency(%q) = %v, want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseCurrencyRoundTrip(t *testing.T) {
+	for _, amount := range []float64{0, 0.01, 19.99, -250.5, 1234567.89} {
+		for _, formatted := range []string{FormatCurrency(amount), FormatCurrencyGrouped(amount)} {
+			got, err := ParseCurrency(formatted)
+			if err != nil {
+				t.Fatalf("ParseCurrency(%q) unexpected error: %v", formatted, err)
+			}
+			if got != amount {
+				t.Errorf("ParseCurrency(%q) = %v, want %v", formatted, got, amount)
+			}
+		}
+	}
+}
+
+func TestFormatCurrencyAccounting(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		expected string
+	}{
+		{name: "negative", amount: -1234.5, expected: "($1,234.50)"},
+		{name: "negative_small", amount: -0.5, expected: "($0.50)"},
+		{name: "positive", amount: 1234.5, expected: "$1,234.50"},
+		{name: "zero", amount: 0, expected: "$0.00"},
+		{name: "negative_zero", amount: math.Copysign(0, -1), expected: "$0.00"},
+		{name: "rounds_to_zero", amount: -0.004, expected: "$0.00"},
+		{name: "nan", amount: math.NaN(), expected: "Invalid amount"},
+		{name: "infinity", amount: math.Inf(-1), expected: "Invalid amount"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatCurrencyAccounting(tt.amount); got != tt.expected {
+				t.Errorf("FormatCurrencyAccounting(%v) = %q, want %q", tt.amount, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"errors"
+	"math"
+)
+
+// SolveQuadratic returns the real roots of ax² + bx + c = 0 in ascending
+// order: two roots for a positive discriminant and one for a zero
+// discriminant. A negative discriminant gives an empty slice and
+// ErrNoRealRoots.
+func SolveQuadratic(a, b, c float64) (roots []float64, err error) {
+	if math.IsNaN(a) || math.IsNaN(b) || math.IsNaN(c) {
+		return nil, ErrNaN
+	}
+
+	if math.IsInf(a, 0) || math.IsInf(b, 0) || math.IsInf(c, 0) {
+		return nil, ErrInfinite
+	}
+
+	if a == 0 {
+		return nil, errors.New("not a quadratic equation: a must be non-zero")
+	}
+
+	discriminant := b*b - 4*a*c
+	// Both terms overflowing to +Inf leaves Inf - Inf, which is NaN
+	if math.IsInf(discriminant, 0) || math.IsNaN(discriminant) {
+		return nil, ErrOverflow
+	}
+
+	switch {
+	case discriminant < 0:
+		return []float64{}, ErrNoRealRoots
+	case discriminant == 0:
+		return []float64{-b / (2 * a)}, nil
+	}
+
+	// Computing q first avoids the cancellation -b + sqrt(D) suffers when
+	// b² is much larger than 4ac
+	q := -(b + math.Copysign(math.Sqrt(discriminant), b)) / 2
+	x1, x2 := q/a, c/q
+	if x1 > x2 {
+		x1, x2 = x2, x1
+	}
+	return []float64{x1, x2}, nil
+}
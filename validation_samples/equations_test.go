@@ -0,0 +1,61 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestSolveQuadratic(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b, c  float64
+		expected []float64
+		wantErr  bool
+	}{
+		{name: "two_roots", a: 1, b: -3, c: 2, expected: []float64{1, 2}},
+		{name: "two_roots_negative_a", a: -2, b: 0, c: 8, expected: []float64{-2, 2}},
+		{name: "double_root", a: 1, b: 2, c: 1, expected: []float64{-1}},
+		{name: "zero_roots_at_origin", a: 3, b: 0, c: 0, expected: []float64{0}},
+		{name: "root_at_zero", a: 1, b: -5, c: 0, expected: []float64{0, 5}},
+		{name: "cancellation_prone", a: 1, b: 1e8, c: 1, expected: []float64{-1e8, -1e-8}},
+		{name: "not_quadratic", a: 0, b: 2, c: 1, wantErr: true},
+		{name: "nan_coefficient", a: 1, b: math.NaN(), c: 1, wantErr: true},
+		{name: "infinite_coefficient", a: 1, b: 1, c: math.Inf(-1), wantErr: true},
+		{name: "discriminant_overflow", a: 1, b: 1e200, c: 1, wantErr: true},
+		{name: "discriminant_both_terms_overflow", a: 1e200, b: 1e200, c: 1e200, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roots, err := SolveQuadratic(tt.a, tt.b, tt.c)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("SolveQuadratic(%v, %v, %v) = %v, want error", tt.a, tt.b, tt.c, roots)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SolveQuadratic(%v, %v, %v) unexpected error: %v", tt.a, tt.b, tt.c, err)
+			}
+			if len(roots) != len(tt.expected) {
+				t.Fatalf("SolveQuadratic(%v, %v, %v) = %v, want %v", tt.a, tt.b, tt.c, roots, tt.expected)
+			}
+			for i := range roots {
+				if math.Abs(roots[i]-tt.expected[i]) > 1e-12*math.Max(1, math.Abs(tt.expected[i])) {
+					t.Errorf("SolveQuadratic(%v, %v, %v) = %v, want %v", tt.a, tt.b, tt.c, roots, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestSolveQuadratic_NoRealRoots(t *testing.T) {
+	roots, err := SolveQuadratic(1, 0, 1)
+	if !errors.Is(err, ErrNoRealRoots) {
+		t.Fatalf("SolveQuadratic(1, 0, 1) error = %v, want %v", err, ErrNoRealRoots)
+	}
+	if roots == nil || len(roots) != 0 {
+		t.Errorf("SolveQuadratic(1, 0, 1) roots = %#v, want an empty slice", roots)
+	}
+}
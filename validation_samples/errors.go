@@ -0,0 +1,28 @@
+package main
+
+import (
+	"errors"
+)
+
+// Sentinel errors returned by Calculator methods and the standalone helpers.
+// Callers can test for them with errors.Is, since some call sites wrap them
+// with additional context.
+var (
+	// ErrNaN is returned when an input is NaN
+	ErrNaN = errors.New("NaN values not allowed")
+	// ErrInfinite is returned when an input is positive or negative infinity
+	ErrInfinite = errors.New("infinite values not allowed")
+	// ErrDivByZero is returned when dividing by zero
+	ErrDivByZero = errors.New("division by zero is not allowed")
+	// ErrNegative is returned when an input is outside the non-negative (or,
+	// for dimensions, positive) domain an operation requires
+	ErrNegative = errors.New("input must be non-negative")
+	// ErrOverflow is returned when finite inputs produce an infinite result
+	ErrOverflow = errors.New("arithmetic overflow")
+	// ErrIntegerOverflow is returned when an integer result does not fit in
+	// an int
+	ErrIntegerOverflow = errors.New("integer overflow")
+	// ErrNoRealRoots is returned by SolveQuadratic when the discriminant is
+	// negative
+	ErrNoRealRoots = errors.New("no real roots")
+)
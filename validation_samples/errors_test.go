@@ -0,0 +1,57 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	calc := NewCalculator()
+
+	tests := []struct {
+		name string
+		call func() error
+		want error
+	}{
+		{name: "add_nan", call: func() error { _, err := calc.Add(math.NaN(), 1); return err }, want: ErrNaN},
+		{name: "add_inf", call: func() error { _, err := calc.Add(1, math.Inf(1)); return err }, want: ErrInfinite},
+		{name: "divide_nan", call: func() error { _, err := calc.Divide(math.NaN(), 1); return err }, want: ErrNaN},
+		{name: "divide_by_zero", call: func() error { _, err := calc.Divide(1, 0); return err }, want: ErrDivByZero},
+		{name: "fibonacci_negative", call: func() error { _, err := calc.Fibonacci(-1); return err }, want: ErrNegative},
+		{name: "area_negative", call: func() error { _, err := CalculateArea(-1, 2); return err }, want: ErrNegative},
+		{name: "area_zero", call: func() error { _, err := CalculateArea(0, 2); return err }, want: ErrNegative},
+		{name: "area_nan", call: func() error { _, err := CalculateArea(math.NaN(), 2); return err }, want: ErrNaN},
+		{name: "multiply_overflow", call: func() error { _, err := calc.Multiply(1e300, 1e300); return err }, want: ErrOverflow},
+		{name: "power_zero_negative_exponent", call: func() error { _, err := calc.Power(0, -1); return err }, want: ErrDivByZero},
+		{name: "eval_division_by_zero", call: func() error { _, err := calc.Eval("1/0"); return err }, want: ErrDivByZero},
+		{name: "factorial_negative", call: func() error { _, err := Factorial(-3); return err }, want: ErrNegative},
+		{name: "factorial_overflow", call: func() error { _, err := Factorial(21); return err }, want: ErrIntegerOverflow},
+		{name: "lcm_overflow", call: func() error { _, err := LCM(1<<40, (1<<30)+1); return err }, want: ErrIntegerOverflow},
+		{name: "quadratic_no_real_roots", call: func() error { _, err := SolveQuadratic(1, 0, 1); return err }, want: ErrNoRealRoots},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if !errors.Is(err, tt.want) {
+				t.Errorf("error = %v, want errors.Is(err, %v)", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSentinelErrors_Distinct(t *testing.T) {
+	_, err := NewCalculator().Divide(1, 0)
+	if errors.Is(err, ErrNaN) || errors.Is(err, ErrNegative) {
+		t.Errorf("Divide(1, 0) error %v matches an unrelated sentinel", err)
+	}
+}
+
+func TestSentinelErrors_WrappedMessage(t *testing.T) {
+	_, err := CalculateArea(-1, 2)
+	want := "width and height must be positive: input must be non-negative"
+	if err == nil || err.Error() != want {
+		t.Errorf("CalculateArea(-1, 2) error = %v, want %q", err, want)
+	}
+}
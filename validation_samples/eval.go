@@ -0,0 +1,189 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Eval evaluates an infix arithmetic expression such as "(2+3)*4" using the
+// usual precedence of * and / over + and -. Unary minus is supported. The
+// expression and its value are recorded in History.
+func (c *Calculator) Eval(expr string) (float64, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(tokens) == 0 {
+		return 0, errors.New("empty expression")
+	}
+
+	p := &exprParser{tokens: tokens}
+	result, err := p.parseExpr()
+	if err != nil {
+		return 0, err
+	}
+
+	if p.pos < len(p.tokens) {
+		tok := p.tokens[p.pos]
+		if tok.text == ")" {
+			return 0, errors.New("unbalanced parentheses")
+		}
+		return 0, fmt.Errorf("unexpected token %q at position %d", tok.text, tok.pos)
+	}
+
+	if math.IsInf(result, 0) || math.IsNaN(result) {
+		return 0, ErrOverflow
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	c.record(fmt.Sprintf("%s = %s", strings.TrimSpace(expr), c.formatNumber(result)), result)
+	return result, nil
+}
+
+// exprToken is a number, operator or parenthesis in an expression
+type exprToken struct {
+	text  string
+	value float64
+	pos   int
+}
+
+// isNumber reports whether the token is a numeric literal
+func (t exprToken) isNumber() bool {
+	return t.text != "" && (t.text[0] == '.' || (t.text[0] >= '0' && t.text[0] <= '9'))
+}
+
+// tokenize splits expr into numbers, operators and parentheses
+func tokenize(expr string) ([]exprToken, error) {
+	var tokens []exprToken
+	for i := 0; i < len(expr); {
+		ch := expr[i]
+		switch {
+		case ch == ' ' || ch == '\t':
+			i++
+		case strings.IndexByte("+-*/()", ch) >= 0:
+			tokens = append(tokens, exprToken{text: string(ch), pos: i})
+			i++
+		case ch == '.' || (ch >= '0' && ch <= '9'):
+			start := i
+			for i < len(expr) && (expr[i] == '.' || (expr[i] >= '0' && expr[i] <= '9')) {
+				i++
+			}
+			text := expr[start:i]
+			value, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q at position %d", text, start)
+			}
+			tokens = append(tokens, exprToken{text: text, value: value, pos: start})
+		default:
+			return nil, fmt.Errorf("unknown token %q at position %d", string(ch), i)
+		}
+	}
+	return tokens, nil
+}
+
+// exprParser is a recursive-descent parser over a token stream:
+//
+//	expr   = term { ("+" | "-") term }
+//	term   = factor { ("*" | "/") factor }
+//	factor = ("+" | "-") factor | number | "(" expr ")"
+type exprParser struct {
+	tokens []exprToken
+	pos    int
+}
+
+// peek returns the current token text, or "" at the end of input
+func (p *exprParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos].text
+}
+
+func (p *exprParser) parseExpr() (float64, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return 0, err
+	}
+
+	for op := p.peek(); op == "+" || op == "-"; op = p.peek() {
+		p.pos++
+		right, err := p.parseTerm()
+		if err != nil {
+			return 0, err
+		}
+		if op == "+" {
+			left += right
+		} else {
+			left -= right
+		}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseTerm() (float64, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return 0, err
+	}
+
+	for op := p.peek(); op == "*" || op == "/"; op = p.peek() {
+		p.pos++
+		right, err := p.parseFactor()
+		if err != nil {
+			return 0, err
+		}
+		if op == "*" {
+			left *= right
+		} else {
+			if right == 0 {
+				return 0, ErrDivByZero
+			}
+			left /= right
+		}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseFactor() (float64, error) {
+	if p.pos >= len(p.tokens) {
+		return 0, errors.New("unexpected end of expression")
+	}
+
+	tok := p.tokens[p.pos]
+	switch {
+	case tok.text == "+" || tok.text == "-":
+		p.pos++
+		value, err := p.parseFactor()
+		if err != nil {
+			return 0, err
+		}
+		if tok.text == "-" {
+			value = -value
+		}
+		return value, nil
+	case tok.text == "(":
+		p.pos++
+		value, err := p.parseExpr()
+		if err != nil {
+			return 0, err
+		}
+		if p.peek() != ")" {
+			return 0, errors.New("unbalanced parentheses")
+		}
+		p.pos++
+		return value, nil
+	case tok.isNumber():
+		p.pos++
+		return tok.value, nil
+	case tok.text == ")":
+		return 0, errors.New("unbalanced parentheses")
+	default:
+		return 0, fmt.Errorf("unexpected token %q at position %d", tok.text, tok.pos)
+	}
+}
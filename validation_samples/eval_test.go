@@ -0,0 +1,95 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCalculatorEval(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     string
+		expected float64
+	}{
+		{name: "single_number", expr: "42", expected: 42},
+		{name: "precedence", expr: "2+3*4", expected: 14},
+		{name: "parentheses", expr: "(2+3)*4", expected: 20},
+		{name: "left_associative_subtraction", expr: "10-4-3", expected: 3},
+		{name: "left_associative_division", expr: "100/10/5", expected: 2},
+		{name: "nested_parentheses", expr: "((1+2)*(3+4))/7", expected: 3},
+		{name: "whitespace", expr: "  2 * ( 3 + 4 ) ", expected: 14},
+		{name: "decimals", expr: "1.5*4", expected: 6},
+		{name: "unary_minus", expr: "-3+5", expected: 2},
+		{name: "unary_minus_parenthesized", expr: "-(2+3)*2", expected: -10},
+		{name: "double_negative", expr: "4--2", expected: 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			result, err := calc.Eval(tt.expr)
+			if err != nil {
+				t.Fatalf("Eval(%q) unexpected error: %v", tt.expr, err)
+			}
+			if result != tt.expected || calc.Result != tt.expected {
+				t.Errorf("Eval(%q) = %v (Result %v), want %v", tt.expr, result, calc.Result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculatorEval_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{name: "empty", expr: "   ", wantErr: "empty expression"},
+		{name: "missing_close_paren", expr: "(2+3", wantErr: "unbalanced parentheses"},
+		{name: "extra_close_paren", expr: "2+3)", wantErr: "unbalanced parentheses"},
+		{name: "leading_close_paren", expr: ")2", wantErr: "unbalanced parentheses"},
+		{name: "unknown_token", expr: "2^3", wantErr: `unknown token "^" at position 1`},
+		{name: "letters", expr: "2+x", wantErr: `unknown token "x" at position 2`},
+		{name: "division_by_zero", expr: "1/(3-3)", wantErr: "division by zero is not allowed"},
+		{name: "trailing_operator", expr: "2+", wantErr: "unexpected end of expression"},
+		{name: "adjacent_numbers", expr: "2 3", wantErr: `unexpected token "3" at position 2`},
+		{name: "malformed_number", expr: "1.2.3", wantErr: `invalid number "1.2.3" at position 0`},
+		{name: "empty_parentheses", expr: "()", wantErr: "unbalanced parentheses"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			_, err := calc.Eval(tt.expr)
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("Eval(%q) error = %v, want %q", tt.expr, err, tt.wantErr)
+			}
+			if len(calc.History) != 0 {
+				t.Errorf("History = %v, want empty after error", calc.History)
+			}
+		})
+	}
+}
+
+func TestCalculatorEval_History(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Eval(" (2+3)*4 "); err != nil {
+		t.Fatalf("Eval unexpected error: %v", err)
+	}
+
+	want := "(2+3)*4 = 20.00"
+	if len(calc.History) != 1 || calc.History[0] != want {
+		t.Errorf("History = %v, want [%q]", calc.History, want)
+	}
+
+	if err := calc.Undo(); err != nil || calc.Result != 0 {
+		t.Errorf("Undo after Eval: err %v, Result %v; want nil, 0", err, calc.Result)
+	}
+}
+
+func TestCalculatorEval_Overflow(t *testing.T) {
+	expr := "1" + strings.Repeat("0", 300) + "*1" + strings.Repeat("0", 300)
+	if _, err := NewCalculator().Eval(expr); err == nil || err.Error() != "arithmetic overflow" {
+		t.Errorf("Eval(huge product) error = %v, want %q", err, "arithmetic overflow")
+	}
+}
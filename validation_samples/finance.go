@@ -0,0 +1,113 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// SplitBill adds a tip of tipPercent to total and divides the bill evenly
+// among people, rounding each share to the nearest cent
+func SplitBill(total, tipPercent float64, people int) (perPerson float64, err error) {
+	if math.IsNaN(total) || math.IsNaN(tipPercent) {
+		return 0, ErrNaN
+	}
+
+	if math.IsInf(total, 0) || math.IsInf(tipPercent, 0) {
+		return 0, ErrInfinite
+	}
+
+	if people <= 0 {
+		return 0, errors.New("number of people must be positive")
+	}
+
+	if total < 0 || tipPercent < 0 {
+		return 0, fmt.Errorf("total and tip must be non-negative: %w", ErrNegative)
+	}
+
+	withTip := total * (1 + tipPercent/100)
+	if math.IsInf(withTip, 0) {
+		return 0, ErrOverflow
+	}
+	return roundPlaces(withTip/float64(people), 2, math.Round), nil
+}
+
+// CompoundInterest returns the balance after investing principal for years
+// at annualRate compounded timesPerYear times a year, P(1 + r/n)^(nt). The
+// rate is a fraction, so 0.05 means 5%.
+func CompoundInterest(principal, annualRate float64, timesPerYear, years int) (float64, error) {
+	if err := checkInterestArgs(principal, annualRate, years); err != nil {
+		return 0, err
+	}
+
+	if timesPerYear <= 0 {
+		return 0, errors.New("compounding frequency must be positive")
+	}
+
+	n := float64(timesPerYear)
+	balance := principal * math.Pow(1+annualRate/n, n*float64(years))
+	if math.IsInf(balance, 0) {
+		return 0, ErrOverflow
+	}
+	return balance, nil
+}
+
+// SimpleInterest returns the balance after investing principal for years at
+// annualRate without compounding, P(1 + rt). Like CompoundInterest, the rate
+// is a fraction.
+func SimpleInterest(principal, annualRate float64, years int) (float64, error) {
+	if err := checkInterestArgs(principal, annualRate, years); err != nil {
+		return 0, err
+	}
+
+	balance := principal * (1 + annualRate*float64(years))
+	if math.IsInf(balance, 0) {
+		return 0, ErrOverflow
+	}
+	return balance, nil
+}
+
+// checkInterestArgs validates the inputs shared by the interest calculators
+func checkInterestArgs(principal, annualRate float64, years int) error {
+	if math.IsNaN(principal) || math.IsNaN(annualRate) {
+		return ErrNaN
+	}
+
+	if math.IsInf(principal, 0) || math.IsInf(annualRate, 0) {
+		return ErrInfinite
+	}
+
+	if principal < 0 || annualRate < 0 || years < 0 {
+		return fmt.Errorf("principal, rate and years must be non-negative: %w", ErrNegative)
+	}
+	return nil
+}
+
+// ApplyDiscounts applies each percentage discount in turn to the remaining
+// price, so 10% then 20% off 100 gives 72 rather than 70
+func ApplyDiscounts(price float64, discounts ...float64) (float64, error) {
+	if math.IsNaN(price) {
+		return 0, ErrNaN
+	}
+
+	if math.IsInf(price, 0) {
+		return 0, ErrInfinite
+	}
+
+	if price < 0 {
+		return 0, fmt.Errorf("price must be non-negative: %w", ErrNegative)
+	}
+
+	for _, d := range discounts {
+		if math.IsNaN(d) {
+			return 0, ErrNaN
+		}
+
+		if d < 0 || d > 100 {
+			return 0, fmt.Errorf("discount %v%% is outside the range 0 to 100", d)
+		}
+
+		price *= 1 - d/100
+	}
+	return price, nil
+}
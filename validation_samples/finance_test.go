@@ -0,0 +1,193 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestSplitBill(t *testing.T) {
+	tests := []struct {
+		name       string
+		total      float64
+		tipPercent float64
+		people     int
+		expected   float64
+		wantErr    bool
+		errIs      error
+	}{
+		{name: "hundred_eighteen_percent_four_people", total: 100, tipPercent: 18, people: 4, expected: 29.5},
+		{name: "no_tip", total: 60, tipPercent: 0, people: 3, expected: 20},
+		{name: "single_diner", total: 42.5, tipPercent: 20, people: 1, expected: 51},
+		{name: "rounds_to_cents", total: 100, tipPercent: 0, people: 3, expected: 33.33},
+		{name: "rounds_half_up", total: 10.05, tipPercent: 0, people: 1, expected: 10.05},
+		{name: "zero_total", total: 0, tipPercent: 15, people: 2, expected: 0},
+		{name: "zero_people", total: 100, tipPercent: 18, people: 0, wantErr: true},
+		{name: "negative_people", total: 100, tipPercent: 18, people: -2, wantErr: true},
+		{name: "negative_total", total: -100, tipPercent: 18, people: 4, wantErr: true, errIs: ErrNegative},
+		{name: "negative_tip", total: 100, tipPercent: -5, people: 4, wantErr: true, errIs: ErrNegative},
+		{name: "nan_total", total: math.NaN(), tipPercent: 18, people: 4, wantErr: true, errIs: ErrNaN},
+		{name: "infinite_tip", total: 100, tipPercent: math.Inf(1), people: 4, wantErr: true, errIs: ErrInfinite},
+		{name: "overflow", total: math.MaxFloat64, tipPercent: 100, people: 1, wantErr: true, errIs: ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := SplitBill(tt.total, tt.tipPercent, tt.people)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("SplitBill(%v, %v, %d) = %v, want error", tt.total, tt.tipPercent, tt.people, result)
+				} else if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+					t.Errorf("SplitBill(%v, %v, %d) error = %v, want %v", tt.total, tt.tipPercent, tt.people, err, tt.errIs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SplitBill(%v, %v, %d) unexpected error: %v", tt.total, tt.tipPercent, tt.people, err)
+			}
+			if result != tt.expected {
+				t.Errorf("SplitBill(%v, %v, %d) = %v, want %v", tt.total, tt.tipPercent, tt.people, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCompoundInterest(t *testing.T) {
+	tests := []struct {
+		name         string
+		principal    float64
+		annualRate   float64
+		timesPerYear int
+		years        int
+		expected     float64
+		wantErr      bool
+		errIs        error
+	}{
+		// 1500 at 4.3% compounded quarterly for 6 years
+		{name: "textbook_quarterly", principal: 1500, annualRate: 0.043, timesPerYear: 4, years: 6, expected: 1938.84},
+		{name: "annual", principal: 1000, annualRate: 0.05, timesPerYear: 1, years: 10, expected: 1628.89},
+		{name: "monthly", principal: 1000, annualRate: 0.05, timesPerYear: 12, years: 10, expected: 1647.01},
+		{name: "zero_rate", principal: 500, annualRate: 0, timesPerYear: 12, years: 30, expected: 500},
+		{name: "zero_years", principal: 500, annualRate: 0.1, timesPerYear: 12, years: 0, expected: 500},
+		{name: "negative_principal", principal: -1, annualRate: 0.05, timesPerYear: 1, years: 1, wantErr: true, errIs: ErrNegative},
+		{name: "negative_rate", principal: 1000, annualRate: -0.05, timesPerYear: 1, years: 1, wantErr: true, errIs: ErrNegative},
+		{name: "negative_years", principal: 1000, annualRate: 0.05, timesPerYear: 1, years: -1, wantErr: true, errIs: ErrNegative},
+		{name: "zero_frequency", principal: 1000, annualRate: 0.05, timesPerYear: 0, years: 1, wantErr: true},
+		{name: "negative_frequency", principal: 1000, annualRate: 0.05, timesPerYear: -4, years: 1, wantErr: true},
+		{name: "nan_rate", principal: 1000, annualRate: math.NaN(), timesPerYear: 1, years: 1, wantErr: true, errIs: ErrNaN},
+		{name: "overflow", principal: 1e300, annualRate: 10, timesPerYear: 1, years: 1000, wantErr: true, errIs: ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CompoundInterest(tt.principal, tt.annualRate, tt.timesPerYear, tt.years)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("CompoundInterest(%v, %v, %d, %d) = %v, want error", tt.principal, tt.annualRate, tt.timesPerYear, tt.years, result)
+				} else if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+					t.Errorf("CompoundInterest(%v, %v, %d, %d) error = %v, want %v", tt.principal, tt.annualRate, tt.timesPerYear, tt.years, err, tt.errIs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CompoundInterest(%v, %v, %d, %d) unexpected error: %v", tt.principal, tt.annualRate, tt.timesPerYear, tt.years, err)
+			}
+			if math.Abs(result-tt.expected) > 0.005 {
+				t.Errorf("CompoundInterest(%v, %v, %d, %d) = %v, want %v", tt.principal, tt.annualRate, tt.timesPerYear, tt.years, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSimpleInterest(t *testing.T) {
+	tests := []struct {
+		name       string
+		principal  float64
+		annualRate float64
+		years      int
+		expected   float64
+		wantErr    bool
+	}{
+		{name: "textbook", principal: 1000, annualRate: 0.05, years: 3, expected: 1150},
+		{name: "zero_rate", principal: 750, annualRate: 0, years: 5, expected: 750},
+		{name: "zero_years", principal: 750, annualRate: 0.2, years: 0, expected: 750},
+		{name: "negative_principal", principal: -1000, annualRate: 0.05, years: 3, wantErr: true},
+		{name: "negative_rate", principal: 1000, annualRate: -0.05, years: 3, wantErr: true},
+		{name: "negative_years", principal: 1000, annualRate: 0.05, years: -3, wantErr: true},
+		{name: "infinite_principal", principal: math.Inf(1), annualRate: 0.05, years: 3, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := SimpleInterest(tt.principal, tt.annualRate, tt.years)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("SimpleInterest(%v, %v, %d) = %v, want error", tt.principal, tt.annualRate, tt.years, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SimpleInterest(%v, %v, %d) unexpected error: %v", tt.principal, tt.annualRate, tt.years, err)
+			}
+			if math.Abs(result-tt.expected) > 1e-9 {
+				t.Errorf("SimpleInterest(%v, %v, %d) = %v, want %v", tt.principal, tt.annualRate, tt.years, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSimpleInterestNeverExceedsCompound(t *testing.T) {
+	simple, err := SimpleInterest(1000, 0.05, 10)
+	if err != nil {
+		t.Fatalf("SimpleInterest() unexpected error: %v", err)
+	}
+	compound, err := CompoundInterest(1000, 0.05, 1, 10)
+	if err != nil {
+		t.Fatalf("CompoundInterest() unexpected error: %v", err)
+	}
+	if simple > compound {
+		t.Errorf("SimpleInterest() = %v exceeds CompoundInterest() = %v", simple, compound)
+	}
+}
+
+func TestApplyDiscounts(t *testing.T) {
+	tests := []struct {
+		name      string
+		price     float64
+		discounts []float64
+		expected  float64
+		wantErr   bool
+	}{
+		{name: "stacked", price: 100, discounts: []float64{10, 20}, expected: 72},
+		{name: "order_independent", price: 100, discounts: []float64{20, 10}, expected: 72},
+		{name: "single", price: 80, discounts: []float64{25}, expected: 60},
+		{name: "none", price: 19.99, expected: 19.99},
+		{name: "zero_discount", price: 50, discounts: []float64{0, 0}, expected: 50},
+		{name: "full_discount", price: 50, discounts: []float64{100, 10}, expected: 0},
+		{name: "three_discounts", price: 200, discounts: []float64{50, 50, 50}, expected: 25},
+		{name: "zero_price", price: 0, discounts: []float64{10}, expected: 0},
+		{name: "negative_price", price: -10, discounts: []float64{10}, wantErr: true},
+		{name: "negative_discount", price: 100, discounts: []float64{10, -5}, wantErr: true},
+		{name: "discount_over_100", price: 100, discounts: []float64{101}, wantErr: true},
+		{name: "nan_discount", price: 100, discounts: []float64{math.NaN()}, wantErr: true},
+		{name: "nan_price", price: math.NaN(), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ApplyDiscounts(tt.price, tt.discounts...)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ApplyDiscounts(%v, %v) = %v, want error", tt.price, tt.discounts, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ApplyDiscounts(%v, %v) unexpected error: %v", tt.price, tt.discounts, err)
+			}
+			if math.Abs(result-tt.expected) > 1e-9 {
+				t.Errorf("ApplyDiscounts(%v, %v) = %v, want %v", tt.price, tt.discounts, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"math/big"
+)
+
+// Fraction is an exact rational number. Arithmetic on Fractions never
+// rounds, and every result is reduced to lowest terms with a positive
+// denominator. The zero value is 0/1.
+type Fraction struct {
+	r *big.Rat
+}
+
+// NewFraction returns num/den reduced to lowest terms, such as 3/4 for
+// NewFraction(6, 8)
+func NewFraction(num, den int64) (Fraction, error) {
+	if den == 0 {
+		return Fraction{}, ErrDivByZero
+	}
+	return Fraction{r: big.NewRat(num, den)}, nil
+}
+
+// Add returns f + g
+func (f Fraction) Add(g Fraction) Fraction {
+	return Fraction{r: new(big.Rat).Add(f.rat(), g.rat())}
+}
+
+// Sub returns f - g
+func (f Fraction) Sub(g Fraction) Fraction {
+	return Fraction{r: new(big.Rat).Sub(f.rat(), g.rat())}
+}
+
+// Mul returns f * g
+func (f Fraction) Mul(g Fraction) Fraction {
+	return Fraction{r: new(big.Rat).Mul(f.rat(), g.rat())}
+}
+
+// Div returns f / g, or ErrDivByZero when g is zero
+func (f Fraction) Div(g Fraction) (Fraction, error) {
+	if g.rat().Sign() == 0 {
+		return Fraction{}, ErrDivByZero
+	}
+	return Fraction{r: new(big.Rat).Quo(f.rat(), g.rat())}, nil
+}
+
+// String formats f as "numerator/denominator", such as "3/4" or "-2/1"
+func (f Fraction) String() string {
+	return f.rat().String()
+}
+
+// DecimalToFraction returns the fraction num/den closest to x among those
+// with 1 <= den <= maxDenominator, such as 1/3 for 0.333. It walks the
+// continued fraction expansion of x's exact binary value and, once the next
+// convergent's denominator would exceed the bound, picks whichever of the
+// last convergent and the largest in-bound semiconvergent is closer. The
+// result is in lowest terms with the sign on num.
+func DecimalToFraction(x float64, maxDenominator int64) (num, den int64, err error) {
+	if math.IsNaN(x) {
+		return 0, 0, ErrNaN
+	}
+
+	if math.IsInf(x, 0) {
+		return 0, 0, ErrInfinite
+	}
+
+	if maxDenominator < 1 {
+		return 0, 0, errors.New("maximum denominator must be at least 1")
+	}
+
+	exact := new(big.Rat).SetFloat64(math.Abs(x))
+	limit := big.NewInt(maxDenominator)
+
+	// p0/q0 and p1/q1 are the previous and latest convergents; n/d is the
+	// remainder of the expansion still to be consumed
+	p0, q0, p1, q1 := big.NewInt(0), big.NewInt(1), big.NewInt(1), big.NewInt(0)
+	n, d := new(big.Int).Set(exact.Num()), new(big.Int).Set(exact.Denom())
+	for d.Sign() != 0 {
+		a := new(big.Int).Quo(n, d)
+		q2 := new(big.Int).Add(q0, new(big.Int).Mul(a, q1))
+		if q2.Cmp(limit) > 0 {
+			break
+		}
+		p2 := new(big.Int).Add(p0, new(big.Int).Mul(a, p1))
+		p0, q0, p1, q1 = p1, q1, p2, q2
+		n, d = d, new(big.Int).Sub(n, new(big.Int).Mul(a, d))
+	}
+
+	p, q := p1, q1
+	if d.Sign() != 0 {
+		k := new(big.Int).Quo(new(big.Int).Sub(limit, q0), q1)
+		sp := new(big.Int).Add(p0, new(big.Int).Mul(k, p1))
+		sq := new(big.Int).Add(q0, new(big.Int).Mul(k, q1))
+		if distance(new(big.Rat).SetFrac(sp, sq), exact).Cmp(distance(new(big.Rat).SetFrac(p1, q1), exact)) < 0 {
+			p, q = sp, sq
+		}
+	}
+
+	if !p.IsInt64() {
+		return 0, 0, ErrIntegerOverflow
+	}
+
+	num, den = p.Int64(), q.Int64()
+	if x < 0 {
+		num = -num
+	}
+	return num, den, nil
+}
+
+// distance returns |a - b|
+func distance(a, b *big.Rat) *big.Rat {
+	d := new(big.Rat).Sub(a, b)
+	return d.Abs(d)
+}
+
+// rat returns the underlying value, treating the zero Fraction as 0/1. The
+// result must not be modified, since Fractions share it when copied.
+func (f Fraction) rat() *big.Rat {
+	if f.r == nil {
+		return new(big.Rat)
+	}
+	return f.r
+}
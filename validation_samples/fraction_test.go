@@ -0,0 +1,156 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// mustFraction builds a Fraction for test inputs known to be valid
+func mustFraction(t *testing.T, num, den int64) Fraction {
+	t.Helper()
+	f, err := NewFraction(num, den)
+	if err != nil {
+		t.Fatalf("NewFraction(%d, %d) unexpected error: %v", num, den, err)
+	}
+	return f
+}
+
+func TestNewFraction(t *testing.T) {
+	tests := []struct {
+		name     string
+		num, den int64
+		expected string
+		wantErr  bool
+	}{
+		{name: "already_reduced", num: 3, den: 4, expected: "3/4"},
+		{name: "reduces", num: 6, den: 8, expected: "3/4"},
+		{name: "whole_number", num: 10, den: 5, expected: "2/1"},
+		{name: "negative_numerator", num: -1, den: 2, expected: "-1/2"},
+		{name: "negative_denominator", num: 1, den: -2, expected: "-1/2"},
+		{name: "both_negative", num: -3, den: -9, expected: "1/3"},
+		{name: "zero", num: 0, den: 7, expected: "0/1"},
+		{name: "zero_denominator", num: 1, den: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewFraction(tt.num, tt.den)
+			if tt.wantErr {
+				if !errors.Is(err, ErrDivByZero) {
+					t.Errorf("NewFraction(%d, %d) error = %v, want %v", tt.num, tt.den, err, ErrDivByZero)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewFraction(%d, %d) unexpected error: %v", tt.num, tt.den, err)
+			}
+			if got := f.String(); got != tt.expected {
+				t.Errorf("NewFraction(%d, %d) = %s, want %s", tt.num, tt.den, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFractionArithmetic(t *testing.T) {
+	third := mustFraction(t, 1, 3)
+	sixth := mustFraction(t, 1, 6)
+	half := mustFraction(t, 1, 2)
+
+	if got := third.Add(sixth); got.rat().Cmp(half.rat()) != 0 {
+		t.Errorf("1/3 + 1/6 = %s, want exactly 1/2", got)
+	}
+
+	tests := []struct {
+		name     string
+		got      Fraction
+		expected string
+	}{
+		{name: "add", got: third.Add(sixth), expected: "1/2"},
+		{name: "sub", got: third.Sub(sixth), expected: "1/6"},
+		{name: "sub_negative", got: sixth.Sub(half), expected: "-1/3"},
+		{name: "mul", got: half.Mul(third), expected: "1/6"},
+		{name: "mul_reduces", got: mustFraction(t, 2, 3).Mul(mustFraction(t, 3, 4)), expected: "1/2"},
+		{name: "zero_value_add", got: Fraction{}.Add(half), expected: "1/2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.String(); got != tt.expected {
+				t.Errorf("got %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFractionDiv(t *testing.T) {
+	got, err := mustFraction(t, 1, 3).Div(mustFraction(t, 2, 9))
+	if err != nil {
+		t.Fatalf("1/3 / 2/9 unexpected error: %v", err)
+	}
+	if got.String() != "3/2" {
+		t.Errorf("1/3 / 2/9 = %s, want 3/2", got)
+	}
+
+	if _, err := mustFraction(t, 1, 3).Div(Fraction{}); !errors.Is(err, ErrDivByZero) {
+		t.Errorf("1/3 / 0 error = %v, want %v", err, ErrDivByZero)
+	}
+}
+
+func TestFractionOperandsUnchanged(t *testing.T) {
+	a := mustFraction(t, 1, 4)
+	b := mustFraction(t, 1, 4)
+	a.Add(b)
+	a.Mul(b)
+	if a.String() != "1/4" || b.String() != "1/4" {
+		t.Errorf("operands changed to %s and %s, want 1/4 and 1/4", a, b)
+	}
+}
+
+func TestDecimalToFraction(t *testing.T) {
+	tests := []struct {
+		name           string
+		x              float64
+		maxDenominator int64
+		num, den       int64
+		wantErr        bool
+		errIs          error
+	}{
+		{name: "half", x: 0.5, maxDenominator: 100, num: 1, den: 2},
+		{name: "third", x: 1.0 / 3, maxDenominator: 100, num: 1, den: 3},
+		{name: "third_truncated", x: 0.333, maxDenominator: 10, num: 1, den: 3},
+		{name: "exact_decimal", x: 0.125, maxDenominator: 1000, num: 1, den: 8},
+		{name: "tenth", x: 0.1, maxDenominator: 1000, num: 1, den: 10},
+		{name: "negative", x: -0.75, maxDenominator: 10, num: -3, den: 4},
+		{name: "whole_number", x: 3, maxDenominator: 10, num: 3, den: 1},
+		{name: "zero", x: 0, maxDenominator: 10, num: 0, den: 1},
+		{name: "pi_convergent", x: math.Pi, maxDenominator: 7, num: 22, den: 7},
+		{name: "pi_semiconvergent", x: math.Pi, maxDenominator: 100, num: 311, den: 99},
+		{name: "pi_fine", x: math.Pi, maxDenominator: 1000, num: 355, den: 113},
+		{name: "denominator_one", x: 0.7, maxDenominator: 1, num: 1, den: 1},
+		{name: "nan", x: math.NaN(), maxDenominator: 10, wantErr: true, errIs: ErrNaN},
+		{name: "infinite", x: math.Inf(1), maxDenominator: 10, wantErr: true, errIs: ErrInfinite},
+		{name: "zero_bound", x: 0.5, maxDenominator: 0, wantErr: true},
+		{name: "numerator_overflow", x: 1e300, maxDenominator: 10, wantErr: true, errIs: ErrIntegerOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			num, den, err := DecimalToFraction(tt.x, tt.maxDenominator)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("DecimalToFraction(%v, %d) = %d/%d, want error", tt.x, tt.maxDenominator, num, den)
+				} else if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+					t.Errorf("DecimalToFraction(%v, %d) error = %v, want %v", tt.x, tt.maxDenominator, err, tt.errIs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DecimalToFraction(%v, %d) unexpected error: %v", tt.x, tt.maxDenominator, err)
+			}
+			if num != tt.num || den != tt.den {
+				t.Errorf("DecimalToFraction(%v, %d) = %d/%d, want %d/%d", tt.x, tt.maxDenominator, num, den, tt.num, tt.den)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HistoryEntry is a history entry together with the time it was recorded
+type HistoryEntry struct {
+	Expression string
+	Timestamp  time.Time
+}
+
+// historySnapshot is the serialized form of a calculator session
+type historySnapshot struct {
+	Result  float64  `json:"result"`
+	History []string `json:"history"`
+}
+
+// HistoryJSON serializes the current Result and History as a JSON object
+func (c *Calculator) HistoryJSON() ([]byte, error) {
+	c.Lock()
+	snapshot := historySnapshot{
+		Result:  c.Result,
+		History: append(make([]string, 0, len(c.History)), c.History...),
+	}
+	c.Unlock()
+
+	return json.Marshal(snapshot)
+}
+
+// LoadHistoryJSON restores a session produced by HistoryJSON, replacing the
+// current Result and History. Undo and redo state is discarded, only the
+// MaxHistory most recent entries are kept when a limit is set, and the
+// restored entries have a zero Timestamp in StructuredHistory.
+func (c *Calculator) LoadHistoryJSON(data []byte) error {
+	var snapshot historySnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return fmt.Errorf("invalid history JSON: %w", err)
+	}
+
+	if math.IsNaN(snapshot.Result) || math.IsInf(snapshot.Result, 0) {
+		return errors.New("invalid history JSON: result must be a finite number")
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	c.History = make([]string, len(snapshot.History))
+	copy(c.History, snapshot.History)
+	c.entries = make([]HistoryEntry, len(snapshot.History))
+	for i, entry := range snapshot.History {
+		c.entries[i] = HistoryEntry{Expression: entry}
+	}
+	c.Result = snapshot.Result
+	c.resultStack = c.resultStack[:0]
+	c.redoStack = c.redoStack[:0]
+	c.trimHistory()
+	return nil
+}
+
+// StructuredHistory returns a copy of the history with the time each entry
+// was recorded, oldest first. It parallels GetHistory entry for entry.
+func (c *Calculator) StructuredHistory() []HistoryEntry {
+	c.Lock()
+	defer c.Unlock()
+
+	entries := make([]HistoryEntry, len(c.entries))
+	copy(entries, c.entries)
+	return entries
+}
+
+// SearchHistory returns the history entries containing substr, ignoring
+// case, oldest first
+func (c *Calculator) SearchHistory(substr string) []string {
+	c.Lock()
+	defer c.Unlock()
+
+	needle := strings.ToLower(substr)
+	matches := make([]string, 0)
+	for _, entry := range c.History {
+		if strings.Contains(strings.ToLower(entry), needle) {
+			matches = append(matches, entry)
+		}
+	}
+	return matches
+}
+
+// LastN returns a copy of the n most recent history entries, oldest first.
+// If n exceeds the history length the whole history is returned.
+func (c *Calculator) LastN(n int) []string {
+	c.Lock()
+	defer c.Unlock()
+
+	n = ClampOf(n, 0, len(c.History))
+	last := make([]string, n)
+	copy(last, c.History[len(c.History)-n:])
+	return last
+}
+
+// HistoryCSV exports the history as CSV with a header row and the columns
+// operation, result and timestamp. The result is the text after the last
+// " = " of each entry, without any trailing '%'; entries that don't have
+// that shape are exported whole with an empty result. Timestamps use
+// RFC 3339 and are left blank for entries restored by LoadHistoryJSON.
+func (c *Calculator) HistoryCSV() (string, error) {
+	entries := c.StructuredHistory()
+
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	if err := w.Write([]string{"operation", "result", "timestamp"}); err != nil {
+		return "", err
+	}
+
+	for _, entry := range entries {
+		operation, result, ok := splitHistoryEntry(entry.Expression)
+		if !ok {
+			operation, result = entry.Expression, ""
+		}
+
+		timestamp := ""
+		if !entry.Timestamp.IsZero() {
+			timestamp = entry.Timestamp.Format(time.RFC3339Nano)
+		}
+
+		if err := w.Write([]string{operation, result, timestamp}); err != nil {
+			return "", err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// AverageResult returns the mean of the results recorded in the history, the
+// text after the last " = " of each entry. Entries whose result isn't a
+// number, such as those from a custom HistoryFormatter, make it fail.
+func (c *Calculator) AverageResult() (float64, error) {
+	history := c.GetHistory()
+	if len(history) == 0 {
+		return 0, errors.New("history is empty")
+	}
+
+	results := make([]float64, len(history))
+	for i, entry := range history {
+		_, recorded, ok := splitHistoryEntry(entry)
+		if !ok {
+			return 0, fmt.Errorf("history entry %d: malformed entry %q", i+1, entry)
+		}
+
+		result, err := strconv.ParseFloat(recorded, 64)
+		if err != nil {
+			return 0, fmt.Errorf("history entry %d: malformed result in %q", i+1, entry)
+		}
+		results[i] = result
+	}
+	return Mean(results)
+}
+
+// splitHistoryEntry splits an entry such as "10.00 + 5.00 = 15.00" into its
+// operation and result text, dropping the '%' PercentChange appends
+func splitHistoryEntry(entry string) (operation, result string, ok bool) {
+	i := strings.LastIndex(entry, " = ")
+	if i < 0 {
+		return "", "", false
+	}
+	return entry[:i], strings.TrimSuffix(entry[i+len(" = "):], "%"), true
+}
@@ -0,0 +1,468 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCalculatorHistoryJSON(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Add(10, 5); err != nil {
+		t.Fatalf("Add(10, 5) unexpected error: %v", err)
+	}
+
+	data, err := calc.HistoryJSON()
+	if err != nil {
+		t.Fatalf("HistoryJSON() unexpected error: %v", err)
+	}
+
+	want := `{"result":15,"history":["10.00 + 5.00 = 15.00"]}`
+	if string(data) != want {
+		t.Errorf("HistoryJSON() = %s, want %s", data, want)
+	}
+}
+
+func TestCalculatorHistoryJSON_RoundTrip(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Add(10, 5); err != nil {
+		t.Fatalf("Add(10, 5) unexpected error: %v", err)
+	}
+	if _, err := calc.Divide(15, 4); err != nil {
+		t.Fatalf("Divide(15, 4) unexpected error: %v", err)
+	}
+
+	data, err := calc.HistoryJSON()
+	if err != nil {
+		t.Fatalf("HistoryJSON() unexpected error: %v", err)
+	}
+
+	var decoded struct {
+		Result  float64  `json:"result"`
+		History []string `json:"history"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() unexpected error: %v", err)
+	}
+
+	if decoded.Result != calc.Result {
+		t.Errorf("decoded result = %v, want %v", decoded.Result, calc.Result)
+	}
+	if len(decoded.History) != len(calc.History) {
+		t.Fatalf("decoded history = %v, want %v", decoded.History, calc.History)
+	}
+	for i := range calc.History {
+		if decoded.History[i] != calc.History[i] {
+			t.Errorf("decoded history[%d] = %q, want %q", i, decoded.History[i], calc.History[i])
+		}
+	}
+}
+
+func TestCalculatorHistoryJSON_Empty(t *testing.T) {
+	data, err := NewCalculator().HistoryJSON()
+	if err != nil {
+		t.Fatalf("HistoryJSON() unexpected error: %v", err)
+	}
+
+	want := `{"result":0,"history":[]}`
+	if string(data) != want {
+		t.Errorf("HistoryJSON() = %s, want %s", data, want)
+	}
+}
+
+func TestCalculatorLoadHistoryJSON_RoundTrip(t *testing.T) {
+	original := NewCalculator()
+	if _, err := original.Add(10, 5); err != nil {
+		t.Fatalf("Add(10, 5) unexpected error: %v", err)
+	}
+	if _, err := original.Multiply(15, 3); err != nil {
+		t.Fatalf("Multiply(15, 3) unexpected error: %v", err)
+	}
+
+	data, err := original.HistoryJSON()
+	if err != nil {
+		t.Fatalf("HistoryJSON() unexpected error: %v", err)
+	}
+
+	restored := NewCalculator()
+	if err := restored.LoadHistoryJSON(data); err != nil {
+		t.Fatalf("LoadHistoryJSON() unexpected error: %v", err)
+	}
+
+	if restored.Result != original.Result {
+		t.Errorf("restored Result = %v, want %v", restored.Result, original.Result)
+	}
+	if len(restored.History) != len(original.History) {
+		t.Fatalf("restored History = %v, want %v", restored.History, original.History)
+	}
+	for i := range original.History {
+		if restored.History[i] != original.History[i] {
+			t.Errorf("restored History[%d] = %q, want %q", i, restored.History[i], original.History[i])
+		}
+	}
+}
+
+func TestCalculatorLoadHistoryJSON_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "malformed_json", data: `{"result": 1, "history": [`},
+		{name: "wrong_field_type", data: `{"result": "fifteen", "history": []}`},
+		{name: "out_of_range_result", data: `{"result": 1e999, "history": []}`},
+		{name: "empty_input", data: ``},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			if _, err := calc.Add(1, 2); err != nil {
+				t.Fatalf("Add(1, 2) unexpected error: %v", err)
+			}
+
+			if err := calc.LoadHistoryJSON([]byte(tt.data)); err == nil {
+				t.Fatalf("LoadHistoryJSON(%q) expected error but got none", tt.data)
+			}
+			if calc.Result != 3 || len(calc.History) != 1 {
+				t.Errorf("calculator state changed after failed load: Result %v, History %v", calc.Result, calc.History)
+			}
+		})
+	}
+}
+
+func TestCalculatorLoadHistoryJSON_ResetsUndo(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Add(1, 2); err != nil {
+		t.Fatalf("Add(1, 2) unexpected error: %v", err)
+	}
+
+	if err := calc.LoadHistoryJSON([]byte(`{"result": 7, "history": ["3.00 + 4.00 = 7.00"]}`)); err != nil {
+		t.Fatalf("LoadHistoryJSON() unexpected error: %v", err)
+	}
+	if err := calc.Undo(); err == nil {
+		t.Errorf("Undo() after LoadHistoryJSON expected error, got nil")
+	}
+}
+
+func TestCalculatorStructuredHistory(t *testing.T) {
+	calc := NewCalculator()
+	before := time.Now()
+	for i := 0; i < 5; i++ {
+		if _, err := calc.Add(float64(i), 1); err != nil {
+			t.Fatalf("Add(%d, 1) unexpected error: %v", i, err)
+		}
+	}
+	after := time.Now()
+
+	entries := calc.StructuredHistory()
+	history := calc.GetHistory()
+	if len(entries) != len(history) {
+		t.Fatalf("len(StructuredHistory()) = %d, want %d", len(entries), len(history))
+	}
+
+	for i, entry := range entries {
+		if entry.Expression != history[i] {
+			t.Errorf("entries[%d].Expression = %q, want %q", i, entry.Expression, history[i])
+		}
+		if entry.Timestamp.Before(before) || entry.Timestamp.After(after) {
+			t.Errorf("entries[%d].Timestamp = %v, want between %v and %v", i, entry.Timestamp, before, after)
+		}
+		if i > 0 && entry.Timestamp.Before(entries[i-1].Timestamp) {
+			t.Errorf("entries[%d].Timestamp = %v is before entries[%d].Timestamp = %v", i, entry.Timestamp, i-1, entries[i-1].Timestamp)
+		}
+	}
+}
+
+func TestCalculatorStructuredHistory_TracksHistory(t *testing.T) {
+	calc := NewCalculator()
+	calc.SetMaxHistory(2)
+	for i := 1; i <= 3; i++ {
+		if _, err := calc.Multiply(float64(i), 2); err != nil {
+			t.Fatalf("Multiply(%d, 2) unexpected error: %v", i, err)
+		}
+	}
+
+	assertParallel := func(step string) {
+		t.Helper()
+		entries := calc.StructuredHistory()
+		history := calc.GetHistory()
+		if len(entries) != len(history) {
+			t.Fatalf("%s: len(StructuredHistory()) = %d, want %d", step, len(entries), len(history))
+		}
+		for i := range entries {
+			if entries[i].Expression != history[i] {
+				t.Errorf("%s: entries[%d].Expression = %q, want %q", step, i, entries[i].Expression, history[i])
+			}
+		}
+	}
+
+	assertParallel("after trim")
+	if err := calc.Undo(); err != nil {
+		t.Fatalf("Undo() unexpected error: %v", err)
+	}
+	assertParallel("after undo")
+	if err := calc.Redo(); err != nil {
+		t.Fatalf("Redo() unexpected error: %v", err)
+	}
+	assertParallel("after redo")
+	calc.ClearHistory()
+	assertParallel("after clear")
+}
+
+func TestCalculatorStructuredHistory_Copy(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Add(1, 2); err != nil {
+		t.Fatalf("Add(1, 2) unexpected error: %v", err)
+	}
+
+	entries := calc.StructuredHistory()
+	entries[0].Expression = "tampered"
+	if got := calc.StructuredHistory()[0].Expression; got == "tampered" {
+		t.Error("StructuredHistory() returned a slice aliasing internal state")
+	}
+}
+
+func TestCalculatorLoadHistoryJSON_StructuredHistory(t *testing.T) {
+	calc := NewCalculator()
+	if err := calc.LoadHistoryJSON([]byte(`{"result": 3, "history": ["1.00 + 2.00 = 3.00"]}`)); err != nil {
+		t.Fatalf("LoadHistoryJSON() unexpected error: %v", err)
+	}
+
+	entries := calc.StructuredHistory()
+	if len(entries) != 1 || entries[0].Expression != "1.00 + 2.00 = 3.00" || !entries[0].Timestamp.IsZero() {
+		t.Errorf("StructuredHistory() = %v, want one entry with a zero timestamp", entries)
+	}
+}
+
+func TestCalculatorLoadHistoryJSON_RespectsMaxHistory(t *testing.T) {
+	calc := NewCalculator()
+	calc.SetMaxHistory(2)
+
+	data := []byte(`{"result": 5, "history": ["0.00 + 1.00 = 1.00", "1.00 + 1.00 = 2.00", "2.00 + 1.00 = 3.00", "3.00 + 1.00 = 4.00", "4.00 + 1.00 = 5.00"]}`)
+	if err := calc.LoadHistoryJSON(data); err != nil {
+		t.Fatalf("LoadHistoryJSON() unexpected error: %v", err)
+	}
+
+	want := []string{"3.00 + 1.00 = 4.00", "4.00 + 1.00 = 5.00"}
+	if got := calc.GetHistory(); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetHistory() = %q, want %q", got, want)
+	}
+
+	entries := calc.StructuredHistory()
+	if len(entries) != len(want) {
+		t.Fatalf("StructuredHistory() = %v, want %d entries", entries, len(want))
+	}
+	for i, entry := range entries {
+		if entry.Expression != want[i] {
+			t.Errorf("StructuredHistory()[%d].Expression = %q, want %q", i, entry.Expression, want[i])
+		}
+	}
+	if calc.Result != 5 {
+		t.Errorf("Result = %v, want 5", calc.Result)
+	}
+}
+
+// populatedCalculator returns a calculator with a few varied history entries
+func populatedCalculator(t *testing.T) *Calculator {
+	t.Helper()
+	calc := NewCalculator()
+	if _, err := calc.Add(10, 5); err != nil {
+		t.Fatalf("Add(10, 5) unexpected error: %v", err)
+	}
+	if _, err := calc.SquareRoot(16); err != nil {
+		t.Fatalf("SquareRoot(16) unexpected error: %v", err)
+	}
+	if _, err := calc.Multiply(3, 4); err != nil {
+		t.Fatalf("Multiply(3, 4) unexpected error: %v", err)
+	}
+	if _, err := calc.Add(1, 2); err != nil {
+		t.Fatalf("Add(1, 2) unexpected error: %v", err)
+	}
+	return calc
+}
+
+func TestCalculatorSearchHistory(t *testing.T) {
+	calc := populatedCalculator(t)
+
+	tests := []struct {
+		name     string
+		substr   string
+		expected []string
+	}{
+		{name: "operator", substr: "+", expected: []string{"10.00 + 5.00 = 15.00", "1.00 + 2.00 = 3.00"}},
+		{name: "function_name", substr: "sqrt", expected: []string{"sqrt(16.00) = 4.00"}},
+		{name: "case_insensitive", substr: "SQRT", expected: []string{"sqrt(16.00) = 4.00"}},
+		{name: "number", substr: "12.00", expected: []string{"3.00 * 4.00 = 12.00"}},
+		{name: "no_match", substr: "log", expected: []string{}},
+		{name: "empty_matches_all", substr: "", expected: calc.GetHistory()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := calc.SearchHistory(tt.substr)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("SearchHistory(%q) = %q, want %q", tt.substr, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculatorLastN(t *testing.T) {
+	calc := populatedCalculator(t)
+	history := calc.GetHistory()
+
+	tests := []struct {
+		name     string
+		n        int
+		expected []string
+	}{
+		{name: "last_two", n: 2, expected: history[2:]},
+		{name: "last_one", n: 1, expected: history[3:]},
+		{name: "all", n: 4, expected: history},
+		{name: "more_than_history", n: 10, expected: history},
+		{name: "zero", n: 0, expected: []string{}},
+		{name: "negative", n: -1, expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := calc.LastN(tt.n)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("LastN(%d) = %q, want %q", tt.n, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculatorHistoryCSV(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Add(10, 5); err != nil {
+		t.Fatalf("Add(10, 5) unexpected error: %v", err)
+	}
+	if _, err := calc.LogBase(8, 2); err != nil {
+		t.Fatalf("LogBase(8, 2) unexpected error: %v", err)
+	}
+	if _, err := calc.PercentChange(50, 75); err != nil {
+		t.Fatalf("PercentChange(50, 75) unexpected error: %v", err)
+	}
+
+	out, err := calc.HistoryCSV()
+	if err != nil {
+		t.Fatalf("HistoryCSV() unexpected error: %v", err)
+	}
+
+	records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	if err != nil {
+		t.Fatalf("csv.ReadAll(HistoryCSV()) unexpected error: %v\n%s", err, out)
+	}
+
+	want := [][]string{
+		{"operation", "result"},
+		{"10.00 + 5.00", "15.00"},
+		// the comma inside the entry must survive quoting
+		{"log(8.00, base 2.00)", "3.00"},
+		{"50.00 -> 75.00", "50.00"},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("HistoryCSV() has %d records, want %d:\n%s", len(records), len(want), out)
+	}
+
+	entries := calc.StructuredHistory()
+	for i, record := range records {
+		if len(record) != 3 {
+			t.Fatalf("record %d = %q, want 3 fields", i, record)
+		}
+		if record[0] != want[i][0] || record[1] != want[i][1] {
+			t.Errorf("record %d = %q, want operation %q and result %q", i, record, want[i][0], want[i][1])
+		}
+		if i == 0 {
+			if record[2] != "timestamp" {
+				t.Errorf("header timestamp column = %q, want %q", record[2], "timestamp")
+			}
+			continue
+		}
+
+		ts, err := time.Parse(time.RFC3339Nano, record[2])
+		if err != nil {
+			t.Errorf("record %d timestamp %q unparseable: %v", i, record[2], err)
+		} else if !ts.Equal(entries[i-1].Timestamp) {
+			t.Errorf("record %d timestamp = %v, want %v", i, ts, entries[i-1].Timestamp)
+		}
+	}
+}
+
+func TestCalculatorHistoryCSV_RestoredHistory(t *testing.T) {
+	calc := NewCalculator()
+	data := `{"result": 0, "history": ["2.00 * 3.00 = 6.00", "note, \"quoted\""]}`
+	if err := calc.LoadHistoryJSON([]byte(data)); err != nil {
+		t.Fatalf("LoadHistoryJSON() unexpected error: %v", err)
+	}
+
+	out, err := calc.HistoryCSV()
+	if err != nil {
+		t.Fatalf("HistoryCSV() unexpected error: %v", err)
+	}
+
+	records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	if err != nil {
+		t.Fatalf("csv.ReadAll(HistoryCSV()) unexpected error: %v\n%s", err, out)
+	}
+
+	want := [][]string{
+		{"operation", "result", "timestamp"},
+		{"2.00 * 3.00", "6.00", ""},
+		{`note, "quoted"`, "", ""},
+	}
+	if !reflect.DeepEqual(records, want) {
+		t.Errorf("HistoryCSV() records = %q, want %q", records, want)
+	}
+}
+
+func TestCalculatorAverageResult(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.AverageResult(); err == nil {
+		t.Error("AverageResult() on empty history expected error")
+	}
+
+	if _, err := calc.Add(10, 5); err != nil {
+		t.Fatalf("Add(10, 5) unexpected error: %v", err)
+	}
+	if _, err := calc.Multiply(3, 4); err != nil {
+		t.Fatalf("Multiply(3, 4) unexpected error: %v", err)
+	}
+	if _, err := calc.Subtract(1, 10); err != nil {
+		t.Fatalf("Subtract(1, 10) unexpected error: %v", err)
+	}
+	if _, err := calc.PercentChange(50, 75); err != nil {
+		t.Fatalf("PercentChange(50, 75) unexpected error: %v", err)
+	}
+
+	// (15 + 12 - 9 + 50) / 4
+	got, err := calc.AverageResult()
+	if err != nil {
+		t.Fatalf("AverageResult() unexpected error: %v", err)
+	}
+	if got != 17 {
+		t.Errorf("AverageResult() = %v, want 17", got)
+	}
+}
+
+func TestCalculatorAverageResult_Malformed(t *testing.T) {
+	calc := NewCalculator()
+	calc.HistoryFormatter = func(op string, a, b, result float64) string { return "added" }
+	if _, err := calc.Add(1, 2); err != nil {
+		t.Fatalf("Add(1, 2) unexpected error: %v", err)
+	}
+	if _, err := calc.AverageResult(); err == nil {
+		t.Error("AverageResult() with a malformed entry expected error")
+	}
+
+	calc = NewCalculator()
+	calc.History = append(calc.History, "total = lots")
+	if _, err := calc.AverageResult(); err == nil {
+		t.Error("AverageResult() with a non-numeric result expected error")
+	}
+}
@@ -0,0 +1,411 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"unicode"
+)
+
+// FibonacciSequence returns the first count Fibonacci numbers, starting at 0
+func FibonacciSequence(count int) ([]int, error) {
+	if count < 0 {
+		return nil, errors.New("count must be non-negative")
+	}
+
+	sequence := make([]int, 0, count)
+	a, b := 0, 1 // F(i-2), F(i-1)
+	for i := 0; i < count; i++ {
+		if i < 2 {
+			sequence = append(sequence, i)
+			continue
+		}
+
+		if a > math.MaxInt-b {
+			return nil, errors.New("sequence would overflow int")
+		}
+		a, b = b, a+b
+		sequence = append(sequence, b)
+	}
+	return sequence, nil
+}
+
+// GCD returns the greatest common divisor of a and b using the Euclidean
+// algorithm. The result is always non-negative and GCD(0, 0) is 0.
+func GCD(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return absInt(a)
+}
+
+// LCM returns the least common multiple of a and b, or an error if it does
+// not fit in an int. LCM(0, x) is 0.
+func LCM(a, b int) (int, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+
+	x, y := absInt(a/GCD(a, b)), absInt(b)
+	if x < 0 || y < 0 || x > math.MaxInt/y {
+		return 0, ErrIntegerOverflow
+	}
+	return x * y, nil
+}
+
+// GCDSlice returns the greatest common divisor of all values
+func GCDSlice(values []int) (int, error) {
+	if len(values) == 0 {
+		return 0, errors.New("no values provided")
+	}
+
+	result := absInt(values[0])
+	for _, v := range values[1:] {
+		result = GCD(result, v)
+	}
+	return result, nil
+}
+
+// LCMSlice returns the least common multiple of all values, or an error if
+// it does not fit in an int. Any zero makes the result 0.
+func LCMSlice(values []int) (int, error) {
+	if len(values) == 0 {
+		return 0, errors.New("no values provided")
+	}
+
+	result := absInt(values[0])
+	for _, v := range values[1:] {
+		var err error
+		if result, err = LCM(result, v); err != nil {
+			return 0, err
+		}
+	}
+	return result, nil
+}
+
+// absInt returns the absolute value of n. math.MinInt has no positive
+// counterpart and is returned unchanged.
+func absInt(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// Factorial returns n!, or an error if n is negative or the result does not
+// fit in an int (n > 20 on 64-bit platforms)
+func Factorial(n int) (int, error) {
+	if n < 0 {
+		return 0, ErrNegative
+	}
+
+	result := 1
+	for i := 2; i <= n; i++ {
+		if result > math.MaxInt/i {
+			return 0, ErrIntegerOverflow
+		}
+		result *= i
+	}
+	return result, nil
+}
+
+// Combinations returns the number of ways to choose k of n items ignoring
+// order, n! / (k! (n-k)!). It returns an error for negative n or k, for k
+// greater than n, or if the result does not fit in an int. Intermediate
+// values are kept small, so results fit whenever the answer does.
+func Combinations(n, k int) (int, error) {
+	if err := checkChoose(n, k); err != nil {
+		return 0, err
+	}
+
+	k = MinOf(k, n-k)
+	result := 1
+	for i := 1; i <= k; i++ {
+		// result * (n-k+i) is divisible by i; cancel the common factor
+		// first so the multiplication overflows only if the result does
+		factor := n - k + i
+		g := GCD(factor, i)
+		factor /= g
+		result /= i / g
+
+		var ok bool
+		if result, ok = mulChecked(result, factor); !ok {
+			return 0, ErrIntegerOverflow
+		}
+	}
+	return result, nil
+}
+
+// Permutations returns the number of ordered arrangements of k of n items,
+// n! / (n-k)!. It returns an error for negative n or k, for k greater than
+// n, or if the result does not fit in an int.
+func Permutations(n, k int) (int, error) {
+	if err := checkChoose(n, k); err != nil {
+		return 0, err
+	}
+
+	result := 1
+	for i := n - k + 1; i <= n; i++ {
+		var ok bool
+		if result, ok = mulChecked(result, i); !ok {
+			return 0, ErrIntegerOverflow
+		}
+	}
+	return result, nil
+}
+
+// checkChoose validates the n and k of Combinations and Permutations
+func checkChoose(n, k int) error {
+	if n < 0 || k < 0 {
+		return fmt.Errorf("n and k must be non-negative: %w", ErrNegative)
+	}
+
+	if k > n {
+		return fmt.Errorf("k (%d) must not exceed n (%d)", k, n)
+	}
+	return nil
+}
+
+// FactorialBig returns n! with arbitrary precision
+func FactorialBig(n int) (*big.Int, error) {
+	if n < 0 {
+		return nil, ErrNegative
+	}
+
+	return new(big.Int).MulRange(1, int64(n)), nil
+}
+
+// DivMod returns the quotient and remainder of a / b using Go's truncated
+// division: the quotient rounds toward zero and the remainder takes the sign
+// of a, so DivMod(-7, 2) is (-3, -1). a == quotient*b + remainder always
+// holds.
+func DivMod(a, b int) (quotient, remainder int, err error) {
+	if b == 0 {
+		return 0, 0, ErrDivByZero
+	}
+
+	if a == math.MinInt && b == -1 {
+		return 0, 0, ErrIntegerOverflow
+	}
+	return a / b, a % b, nil
+}
+
+// IPow returns base raised to exp using exponentiation by squaring, or an
+// error if exp is negative or the result does not fit in an int
+func IPow(base, exp int) (int, error) {
+	if exp < 0 {
+		return 0, fmt.Errorf("exponent must be non-negative: %w", ErrNegative)
+	}
+
+	result := 1
+	for exp > 0 {
+		var ok bool
+		if exp&1 == 1 {
+			if result, ok = mulChecked(result, base); !ok {
+				return 0, ErrIntegerOverflow
+			}
+		}
+
+		exp >>= 1
+		if exp > 0 {
+			if base, ok = mulChecked(base, base); !ok {
+				return 0, ErrIntegerOverflow
+			}
+		}
+	}
+	return result, nil
+}
+
+// AddInt returns a + b, or ErrIntegerOverflow if the sum does not fit in an
+// int instead of silently wrapping around
+func AddInt(a, b int) (int, error) {
+	if (b > 0 && a > math.MaxInt-b) || (b < 0 && a < math.MinInt-b) {
+		return 0, ErrIntegerOverflow
+	}
+	return a + b, nil
+}
+
+// SubInt returns a - b, or ErrIntegerOverflow if the difference does not fit
+// in an int
+func SubInt(a, b int) (int, error) {
+	if (b < 0 && a > math.MaxInt+b) || (b > 0 && a < math.MinInt+b) {
+		return 0, ErrIntegerOverflow
+	}
+	return a - b, nil
+}
+
+// MulInt returns a * b, or ErrIntegerOverflow if the product does not fit in
+// an int
+func MulInt(a, b int) (int, error) {
+	product, ok := mulChecked(a, b)
+	if !ok {
+		return 0, ErrIntegerOverflow
+	}
+	return product, nil
+}
+
+// mulChecked returns a*b and whether the product fit in an int
+func mulChecked(a, b int) (int, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+
+	product := a * b
+	if product/b != a || (a == -1 && b == math.MinInt) || (b == -1 && a == math.MinInt) {
+		return 0, false
+	}
+	return product, true
+}
+
+// IsEven reports whether n is divisible by two
+func IsEven(n int) bool {
+	return n%2 == 0
+}
+
+// IsOdd reports whether n is not divisible by two. Go's remainder takes the
+// sign of the dividend, so -3 % 2 is -1 and the check is against zero.
+func IsOdd(n int) bool {
+	return n%2 != 0
+}
+
+// Collatz returns the Collatz sequence from n down to 1: each even term is
+// halved and each odd term becomes 3n+1. It returns an error if n is less
+// than 1 or a term would overflow an int.
+func Collatz(n int) ([]int, error) {
+	if n < 1 {
+		return nil, errors.New("collatz start must be at least 1")
+	}
+
+	sequence := []int{n}
+	for n != 1 {
+		next, err := collatzNext(n)
+		if err != nil {
+			return nil, err
+		}
+		n = next
+		sequence = append(sequence, n)
+	}
+	return sequence, nil
+}
+
+// CollatzSteps returns how many steps the Collatz sequence from n takes to
+// reach 1, one less than the length of Collatz(n)
+func CollatzSteps(n int) (int, error) {
+	if n < 1 {
+		return 0, errors.New("collatz start must be at least 1")
+	}
+
+	steps := 0
+	for n != 1 {
+		next, err := collatzNext(n)
+		if err != nil {
+			return 0, err
+		}
+		n = next
+		steps++
+	}
+	return steps, nil
+}
+
+// collatzNext returns the Collatz term after n, or ErrIntegerOverflow if
+// 3n+1 doesn't fit in an int
+func collatzNext(n int) (int, error) {
+	if IsEven(n) {
+		return n / 2, nil
+	}
+
+	if n > (math.MaxInt-1)/3 {
+		return 0, ErrIntegerOverflow
+	}
+	return 3*n + 1, nil
+}
+
+// DigitSum returns the sum of the decimal digits of n, ignoring its sign, so
+// DigitSum(-123) is 6
+func DigitSum(n int) int {
+	sum := 0
+	for ; n != 0; n /= 10 {
+		// Take each digit's magnitude rather than negating n, which would
+		// overflow for math.MinInt
+		sum += absInt(n % 10)
+	}
+	return sum
+}
+
+// DigitalRoot repeatedly applies DigitSum to n until a single digit remains,
+// so DigitalRoot(9999) is 9. The sign of n is ignored.
+func DigitalRoot(n int) int {
+	root := DigitSum(n)
+	for root >= 10 {
+		root = DigitSum(root)
+	}
+	return root
+}
+
+// IsPalindrome reports whether the decimal digits of n read the same forwards
+// and backwards, as in 12321. Negative numbers are never palindromes because
+// of the leading '-'.
+func IsPalindrome(n int) bool {
+	if n < 0 {
+		return false
+	}
+	return IsPalindromeString(strconv.Itoa(n))
+}
+
+// IsPalindromeString reports whether s reads the same forwards and backwards
+// considering only its letters and digits, ignoring case, so "A man, a
+// plan, a canal: Panama" is a palindrome. A string with no letters or digits
+// is a palindrome.
+func IsPalindromeString(s string) bool {
+	var runes []rune
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			runes = append(runes, unicode.ToLower(r))
+		}
+	}
+
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		if runes[i] != runes[j] {
+			return false
+		}
+	}
+	return true
+}
+
+// ToBase formats n in the given base between 2 and 36, using lowercase
+// letters for digits above 9 and a leading '-' for negative numbers
+func ToBase(n int, base int) (string, error) {
+	if err := checkBase(base); err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(int64(n), base), nil
+}
+
+// FromBase parses s as an integer in the given base between 2 and 36. Digits
+// above 9 may be upper or lower case and a leading '-' marks a negative
+// number.
+func FromBase(s string, base int) (int, error) {
+	if err := checkBase(base); err != nil {
+		return 0, err
+	}
+
+	n, err := strconv.ParseInt(s, base, strconv.IntSize)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, ErrIntegerOverflow
+	}
+	if err != nil {
+		return 0, fmt.Errorf("invalid base %d number %q", base, s)
+	}
+	return int(n), nil
+}
+
+// checkBase rejects bases outside the 2-36 range that digits 0-9 and a-z
+// can express
+func checkBase(base int) error {
+	if base < 2 || base > 36 {
+		return fmt.Errorf("base must be between 2 and 36, got %d", base)
+	}
+	return nil
+}
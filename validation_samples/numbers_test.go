@@ -0,0 +1,785 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFibonacciSequence(t *testing.T) {
+	tests := []struct {
+		name     string
+		count    int
+		expected []int
+		wantErr  bool
+	}{
+		{name: "zero", count: 0, expected: []int{}},
+		{name: "one", count: 1, expected: []int{0}},
+		{name: "two", count: 2, expected: []int{0, 1}},
+		{name: "ten", count: 10, expected: []int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34}},
+		{name: "negative", count: -1, wantErr: true},
+		{name: "overflow", count: 200, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := FibonacciSequence(tt.count)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("FibonacciSequence(%d) expected error but got none", tt.count)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("FibonacciSequence(%d) unexpected error: %v", tt.count, err)
+			}
+			if result == nil || len(result) != len(tt.expected) {
+				t.Fatalf("FibonacciSequence(%d) = %v, want %v", tt.count, result, tt.expected)
+			}
+			for i := range tt.expected {
+				if result[i] != tt.expected[i] {
+					t.Errorf("FibonacciSequence(%d) = %v, want %v", tt.count, result, tt.expected)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestFibonacciSequence_MatchesFibonacci(t *testing.T) {
+	sequence, err := FibonacciSequence(47)
+	if err != nil {
+		t.Fatalf("FibonacciSequence(47) unexpected error: %v", err)
+	}
+
+	calc := NewCalculator()
+	for n, got := range sequence {
+		if want, _ := calc.Fibonacci(n); got != want {
+			t.Errorf("FibonacciSequence(47)[%d] = %d, want %d", n, got, want)
+		}
+	}
+}
+
+func TestFibonacciSequence_OverflowBoundary(t *testing.T) {
+	// F(92) is the largest Fibonacci number that fits in int64
+	sequence, err := FibonacciSequence(93)
+	if err != nil {
+		t.Fatalf("FibonacciSequence(93) unexpected error: %v", err)
+	}
+	if last := sequence[len(sequence)-1]; last != 7540113804746346429 {
+		t.Errorf("F(92) = %d, want 7540113804746346429", last)
+	}
+
+	if _, err := FibonacciSequence(94); err == nil {
+		t.Errorf("FibonacciSequence(94) expected overflow error but got none")
+	}
+}
+
+func TestGCD(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     int
+		expected int
+	}{
+		{name: "common_factor", a: 48, b: 18, expected: 6},
+		{name: "order_independent", a: 18, b: 48, expected: 6},
+		{name: "coprime", a: 17, b: 31, expected: 1},
+		{name: "one_zero", a: 0, b: 9, expected: 9},
+		{name: "both_zero", a: 0, b: 0, expected: 0},
+		{name: "negative_first", a: -48, b: 18, expected: 6},
+		{name: "negative_second", a: 48, b: -18, expected: 6},
+		{name: "both_negative", a: -48, b: -18, expected: 6},
+		{name: "negative_and_zero", a: -7, b: 0, expected: 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GCD(tt.a, tt.b); got != tt.expected {
+				t.Errorf("GCD(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLCM(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     int
+		expected int
+		wantErr  bool
+	}{
+		{name: "common_factor", a: 4, b: 6, expected: 12},
+		{name: "coprime", a: 7, b: 9, expected: 63},
+		{name: "multiple", a: 5, b: 25, expected: 25},
+		{name: "zero_first", a: 0, b: 12, expected: 0},
+		{name: "zero_second", a: 12, b: 0, expected: 0},
+		{name: "negative_input", a: -4, b: 6, expected: 12},
+		{name: "both_negative", a: -4, b: -6, expected: 12},
+		{name: "overflow", a: math.MaxInt, b: math.MaxInt - 1, wantErr: true},
+		{name: "large_coprime_overflow", a: 1 << 40, b: (1 << 30) + 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := LCM(tt.a, tt.b)
+
+			if tt.wantErr {
+				if err == nil || err.Error() != "integer overflow" {
+					t.Fatalf("LCM(%d, %d) error = %v, want %q", tt.a, tt.b, err, "integer overflow")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("LCM(%d, %d) unexpected error: %v", tt.a, tt.b, err)
+			}
+			if result != tt.expected {
+				t.Errorf("LCM(%d, %d) = %d, want %d", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFactorial(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected int
+		wantErr  string
+	}{
+		{name: "zero", n: 0, expected: 1},
+		{name: "one", n: 1, expected: 1},
+		{name: "five", n: 5, expected: 120},
+		{name: "ten", n: 10, expected: 3628800},
+		{name: "largest_int64", n: 20, expected: 2432902008176640000},
+		{name: "first_overflow", n: 21, wantErr: "integer overflow"},
+		{name: "far_overflow", n: 100, wantErr: "integer overflow"},
+		{name: "negative", n: -1, wantErr: "input must be non-negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Factorial(tt.n)
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Factorial(%d) error = %v, want %q", tt.n, err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Factorial(%d) unexpected error: %v", tt.n, err)
+			}
+			if result != tt.expected {
+				t.Errorf("Factorial(%d) = %d, want %d", tt.n, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCombinationsAndPermutations(t *testing.T) {
+	tests := []struct {
+		name         string
+		n, k         int
+		combinations int
+		permutations int
+		wantErr      bool
+		errIs        error
+	}{
+		{name: "five_choose_two", n: 5, k: 2, combinations: 10, permutations: 20},
+		{name: "choose_zero", n: 5, k: 0, combinations: 1, permutations: 1},
+		{name: "choose_all", n: 5, k: 5, combinations: 1, permutations: 120},
+		{name: "zero_zero", n: 0, k: 0, combinations: 1, permutations: 1},
+		{name: "ten_choose_three", n: 10, k: 3, combinations: 120, permutations: 720},
+		{name: "k_greater_than_n", n: 2, k: 5, wantErr: true},
+		{name: "negative_n", n: -1, k: 0, wantErr: true, errIs: ErrNegative},
+		{name: "negative_k", n: 5, k: -2, wantErr: true, errIs: ErrNegative},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, cErr := Combinations(tt.n, tt.k)
+			p, pErr := Permutations(tt.n, tt.k)
+			if tt.wantErr {
+				if cErr == nil || pErr == nil {
+					t.Fatalf("Combinations(%d, %d), Permutations(%d, %d) = %v, %v, want errors", tt.n, tt.k, tt.n, tt.k, cErr, pErr)
+				}
+				if tt.errIs != nil && (!errors.Is(cErr, tt.errIs) || !errors.Is(pErr, tt.errIs)) {
+					t.Errorf("errors = %v, %v, want %v", cErr, pErr, tt.errIs)
+				}
+				return
+			}
+			if cErr != nil || pErr != nil {
+				t.Fatalf("Combinations(%d, %d), Permutations(%d, %d) unexpected errors: %v, %v", tt.n, tt.k, tt.n, tt.k, cErr, pErr)
+			}
+			if c != tt.combinations {
+				t.Errorf("Combinations(%d, %d) = %d, want %d", tt.n, tt.k, c, tt.combinations)
+			}
+			if p != tt.permutations {
+				t.Errorf("Permutations(%d, %d) = %d, want %d", tt.n, tt.k, p, tt.permutations)
+			}
+		})
+	}
+}
+
+func TestCombinationsAndPermutations_Overflow(t *testing.T) {
+	// C(66, 33) is about 7.2e18 and fits even though 66! does not
+	if got, err := Combinations(66, 33); err != nil || got != 7219428434016265740 {
+		t.Errorf("Combinations(66, 33) = %d, %v, want 7219428434016265740, nil", got, err)
+	}
+	if _, err := Combinations(68, 34); !errors.Is(err, ErrIntegerOverflow) {
+		t.Errorf("Combinations(68, 34) error = %v, want %v", err, ErrIntegerOverflow)
+	}
+
+	if got, err := Permutations(20, 20); err != nil || got != 2432902008176640000 {
+		t.Errorf("Permutations(20, 20) = %d, %v, want 20!, nil", got, err)
+	}
+	if _, err := Permutations(21, 21); !errors.Is(err, ErrIntegerOverflow) {
+		t.Errorf("Permutations(21, 21) error = %v, want %v", err, ErrIntegerOverflow)
+	}
+}
+
+func TestFactorialBig(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected string
+		wantErr  bool
+	}{
+		{name: "zero", n: 0, expected: "1"},
+		{name: "one", n: 1, expected: "1"},
+		{name: "twenty", n: 20, expected: "2432902008176640000"},
+		{name: "twenty_one", n: 21, expected: "51090942171709440000"},
+		{name: "thirty", n: 30, expected: "265252859812191058636308480000000"},
+		{name: "negative", n: -5, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := FactorialBig(tt.n)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("FactorialBig(%d) expected error but got none", tt.n)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("FactorialBig(%d) unexpected error: %v", tt.n, err)
+			}
+			if result.String() != tt.expected {
+				t.Errorf("FactorialBig(%d) = %s, want %s", tt.n, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsEvenIsOdd(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		even bool
+	}{
+		{name: "zero", n: 0, even: true},
+		{name: "one", n: 1, even: false},
+		{name: "two", n: 2, even: true},
+		{name: "negative_odd", n: -3, even: false},
+		{name: "negative_even", n: -4, even: true},
+		{name: "max_int", n: math.MaxInt, even: false},
+		{name: "min_int", n: math.MinInt, even: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsEven(tt.n); got != tt.even {
+				t.Errorf("IsEven(%d) = %v, want %v", tt.n, got, tt.even)
+			}
+			if got := IsOdd(tt.n); got != !tt.even {
+				t.Errorf("IsOdd(%d) = %v, want %v", tt.n, got, !tt.even)
+			}
+		})
+	}
+}
+
+func TestCollatz(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected []int
+		wantErr  bool
+		errIs    error
+	}{
+		{name: "six", n: 6, expected: []int{6, 3, 10, 5, 16, 8, 4, 2, 1}},
+		{name: "one", n: 1, expected: []int{1}},
+		{name: "power_of_two", n: 16, expected: []int{16, 8, 4, 2, 1}},
+		{name: "zero", n: 0, wantErr: true},
+		{name: "negative", n: -5, wantErr: true},
+		{name: "overflow", n: math.MaxInt, wantErr: true, errIs: ErrIntegerOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Collatz(tt.n)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Collatz(%d) = %v, want error", tt.n, result)
+				} else if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+					t.Errorf("Collatz(%d) error = %v, want %v", tt.n, err, tt.errIs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Collatz(%d) unexpected error: %v", tt.n, err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Collatz(%d) = %v, want %v", tt.n, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCollatzSteps(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected int
+		wantErr  bool
+	}{
+		{name: "six", n: 6, expected: 8},
+		{name: "one", n: 1, expected: 0},
+		{name: "twenty_seven", n: 27, expected: 111},
+		{name: "zero", n: 0, wantErr: true},
+		{name: "overflow", n: math.MaxInt, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CollatzSteps(tt.n)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("CollatzSteps(%d) = %d, want error", tt.n, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CollatzSteps(%d) unexpected error: %v", tt.n, err)
+			}
+			if result != tt.expected {
+				t.Errorf("CollatzSteps(%d) = %d, want %d", tt.n, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCollatzStepsMatchesCollatz(t *testing.T) {
+	for n := 1; n <= 50; n++ {
+		sequence, err := Collatz(n)
+		if err != nil {
+			t.Fatalf("Collatz(%d) unexpected error: %v", n, err)
+		}
+		steps, err := CollatzSteps(n)
+		if err != nil {
+			t.Fatalf("CollatzSteps(%d) unexpected error: %v", n, err)
+		}
+		if steps != len(sequence)-1 {
+			t.Errorf("CollatzSteps(%d) = %d, want %d", n, steps, len(sequence)-1)
+		}
+	}
+}
+
+func TestDigitSumAndDigitalRoot(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		sum  int
+		root int
+	}{
+		{name: "zero", n: 0, sum: 0, root: 0},
+		{name: "single_digit", n: 7, sum: 7, root: 7},
+		{name: "three_digits", n: 123, sum: 6, root: 6},
+		{name: "negative", n: -123, sum: 6, root: 6},
+		{name: "repeated_nines", n: 9999, sum: 36, root: 9},
+		{name: "two_passes", n: 987654321, sum: 45, root: 9},
+		{name: "with_zeros", n: 1005, sum: 6, root: 6},
+		{name: "multi_pass_root", n: 199, sum: 19, root: 1},
+		{name: "max_int", n: math.MaxInt, sum: 88, root: 7},
+		{name: "min_int", n: math.MinInt, sum: 89, root: 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DigitSum(tt.n); got != tt.sum {
+				t.Errorf("DigitSum(%d) = %d, want %d", tt.n, got, tt.sum)
+			}
+			if got := DigitalRoot(tt.n); got != tt.root {
+				t.Errorf("DigitalRoot(%d) = %d, want %d", tt.n, got, tt.root)
+			}
+		})
+	}
+}
+
+func TestIsPalindrome(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected bool
+	}{
+		{name: "odd_length", n: 12321, expected: true},
+		{name: "even_length", n: 1221, expected: true},
+		{name: "not_palindrome", n: 12345, expected: false},
+		{name: "single_digit", n: 7, expected: true},
+		{name: "zero", n: 0, expected: true},
+		{name: "trailing_zero", n: 10, expected: false},
+		{name: "negative", n: -121, expected: false},
+		{name: "negative_single_digit", n: -7, expected: false},
+		{name: "min_int", n: math.MinInt, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPalindrome(tt.n); got != tt.expected {
+				t.Errorf("IsPalindrome(%d) = %v, want %v", tt.n, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsPalindromeString(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		expected bool
+	}{
+		{name: "simple", s: "racecar", expected: true},
+		{name: "mixed_case", s: "Level", expected: true},
+		{name: "punctuation_and_spaces", s: "A man, a plan, a canal: Panama", expected: true},
+		{name: "digits", s: "1a2 2A1", expected: true},
+		{name: "unicode", s: "Ésé", expected: true},
+		{name: "not_palindrome", s: "hello", expected: false},
+		{name: "nearly", s: "abca", expected: false},
+		{name: "empty", s: "", expected: true},
+		{name: "punctuation_only", s: "?!", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPalindromeString(tt.s); got != tt.expected {
+				t.Errorf("IsPalindromeString(%q) = %v, want %v", tt.s, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIPow(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     int
+		exp      int
+		expected int
+		wantErr  bool
+	}{
+		{name: "two_to_ten", base: 2, exp: 10, expected: 1024},
+		{name: "zero_exponent", base: 7, exp: 0, expected: 1},
+		{name: "zero_to_zero", base: 0, exp: 0, expected: 1},
+		{name: "zero_base", base: 0, exp: 5, expected: 0},
+		{name: "one_large_exponent", base: 1, exp: math.MaxInt, expected: 1},
+		{name: "negative_one_odd", base: -1, exp: 999, expected: -1},
+		{name: "negative_base_even", base: -3, exp: 4, expected: 81},
+		{name: "negative_base_odd", base: -3, exp: 3, expected: -27},
+		{name: "ten_to_eighteen", base: 10, exp: 18, expected: 1_000_000_000_000_000_000},
+		{name: "largest_power_of_two", base: 2, exp: 62, expected: 1 << 62},
+		{name: "min_int", base: -2, exp: 63, expected: math.MinInt},
+		{name: "overflow_power_of_two", base: 2, exp: 63, wantErr: true},
+		{name: "overflow_power_of_ten", base: 10, exp: 19, wantErr: true},
+		{name: "overflow_large_base", base: 3037000500, exp: 2, wantErr: true},
+		{name: "negative_exponent", base: 2, exp: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := IPow(tt.base, tt.exp)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("IPow(%d, %d) = %d, want error", tt.base, tt.exp, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("IPow(%d, %d) unexpected error: %v", tt.base, tt.exp, err)
+			}
+			if result != tt.expected {
+				t.Errorf("IPow(%d, %d) = %d, want %d", tt.base, tt.exp, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToBase(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		base     int
+		expected string
+		wantErr  bool
+	}{
+		{name: "hex", n: 255, base: 16, expected: "ff"},
+		{name: "binary", n: 10, base: 2, expected: "1010"},
+		{name: "octal", n: 8, base: 8, expected: "10"},
+		{name: "base36", n: 35, base: 36, expected: "z"},
+		{name: "zero", n: 0, base: 2, expected: "0"},
+		{name: "negative_hex", n: -255, base: 16, expected: "-ff"},
+		{name: "min_int_binary", n: math.MinInt, base: 2, expected: "-1" + strings.Repeat("0", 63)},
+		{name: "base_too_small", n: 5, base: 1, wantErr: true},
+		{name: "base_too_large", n: 5, base: 37, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ToBase(tt.n, tt.base)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ToBase(%d, %d) = %q, want error", tt.n, tt.base, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ToBase(%d, %d) unexpected error: %v", tt.n, tt.base, err)
+			}
+			if result != tt.expected {
+				t.Errorf("ToBase(%d, %d) = %q, want %q", tt.n, tt.base, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFromBase(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		base     int
+		expected int
+		wantErr  bool
+	}{
+		{name: "hex_lower", s: "ff", base: 16, expected: 255},
+		{name: "hex_upper", s: "FF", base: 16, expected: 255},
+		{name: "binary", s: "1010", base: 2, expected: 10},
+		{name: "negative_binary", s: "-1010", base: 2, expected: -10},
+		{name: "base36", s: "zz", base: 36, expected: 1295},
+		{name: "max_int_hex", s: "7fffffffffffffff", base: 16, expected: math.MaxInt},
+		{name: "invalid_binary_digit", s: "102", base: 2, wantErr: true},
+		{name: "invalid_hex_digit", s: "fg", base: 16, wantErr: true},
+		{name: "empty", s: "", base: 10, wantErr: true},
+		{name: "overflow", s: "8000000000000000", base: 16, wantErr: true},
+		{name: "invalid_base", s: "10", base: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := FromBase(tt.s, tt.base)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("FromBase(%q, %d) = %d, want error", tt.s, tt.base, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromBase(%q, %d) unexpected error: %v", tt.s, tt.base, err)
+			}
+			if result != tt.expected {
+				t.Errorf("FromBase(%q, %d) = %d, want %d", tt.s, tt.base, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToBaseFromBaseRoundTrip(t *testing.T) {
+	for _, n := range []int{0, 1, -1, 12345, -98765, math.MaxInt, math.MinInt} {
+		for _, base := range []int{2, 8, 10, 16, 36} {
+			s, err := ToBase(n, base)
+			if err != nil {
+				t.Fatalf("ToBase(%d, %d) unexpected error: %v", n, base, err)
+			}
+			got, err := FromBase(s, base)
+			if err != nil {
+				t.Fatalf("FromBase(%q, %d) unexpected error: %v", s, base, err)
+			}
+			if got != n {
+				t.Errorf("FromBase(ToBase(%d, %d)) = %d", n, base, got)
+			}
+		}
+	}
+}
+
+func TestGCDSlice(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected int
+		wantErr  bool
+	}{
+		{name: "three_values", values: []int{12, 18, 24}, expected: 6},
+		{name: "single_value", values: []int{-15}, expected: 15},
+		{name: "coprime", values: []int{9, 28, 15}, expected: 1},
+		{name: "with_zero", values: []int{0, 14, 21}, expected: 7},
+		{name: "all_zero", values: []int{0, 0}, expected: 0},
+		{name: "negatives", values: []int{-12, 18, -24}, expected: 6},
+		{name: "empty", values: []int{}, wantErr: true},
+		{name: "nil", values: nil, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := GCDSlice(tt.values)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("GCDSlice(%v) = %d, want error", tt.values, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GCDSlice(%v) unexpected error: %v", tt.values, err)
+			}
+			if result != tt.expected {
+				t.Errorf("GCDSlice(%v) = %d, want %d", tt.values, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLCMSlice(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected int
+		wantErr  bool
+	}{
+		{name: "two_values", values: []int{4, 6}, expected: 12},
+		{name: "one_through_ten", values: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, expected: 2520},
+		{name: "single_value", values: []int{-9}, expected: 9},
+		{name: "with_zero", values: []int{4, 0, 6}, expected: 0},
+		{name: "negatives", values: []int{-4, 6}, expected: 12},
+		{name: "overflow", values: []int{1 << 40, (1 << 30) + 1, 3}, wantErr: true},
+		{name: "empty", values: []int{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := LCMSlice(tt.values)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("LCMSlice(%v) = %d, want error", tt.values, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LCMSlice(%v) unexpected error: %v", tt.values, err)
+			}
+			if result != tt.expected {
+				t.Errorf("LCMSlice(%v) = %d, want %d", tt.values, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDivMod(t *testing.T) {
+	tests := []struct {
+		name          string
+		a, b          int
+		wantQuotient  int
+		wantRemainder int
+		wantErr       bool
+	}{
+		{name: "exact", a: 12, b: 4, wantQuotient: 3, wantRemainder: 0},
+		{name: "with_remainder", a: 7, b: 2, wantQuotient: 3, wantRemainder: 1},
+		// Truncated division: quotient toward zero, remainder has a's sign
+		{name: "negative_dividend", a: -7, b: 2, wantQuotient: -3, wantRemainder: -1},
+		{name: "negative_divisor", a: 7, b: -2, wantQuotient: -3, wantRemainder: 1},
+		{name: "both_negative", a: -7, b: -2, wantQuotient: 3, wantRemainder: -1},
+		{name: "smaller_dividend", a: 3, b: 5, wantQuotient: 0, wantRemainder: 3},
+		{name: "zero_dividend", a: 0, b: 9, wantQuotient: 0, wantRemainder: 0},
+		{name: "min_int_by_one", a: math.MinInt, b: 1, wantQuotient: math.MinInt, wantRemainder: 0},
+		{name: "division_by_zero", a: 5, b: 0, wantErr: true},
+		{name: "min_int_by_negative_one", a: math.MinInt, b: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quotient, remainder, err := DivMod(tt.a, tt.b)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("DivMod(%d, %d) = %d, %d, want error", tt.a, tt.b, quotient, remainder)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DivMod(%d, %d) unexpected error: %v", tt.a, tt.b, err)
+			}
+			if quotient != tt.wantQuotient || remainder != tt.wantRemainder {
+				t.Errorf("DivMod(%d, %d) = %d, %d, want %d, %d", tt.a, tt.b, quotient, remainder, tt.wantQuotient, tt.wantRemainder)
+			}
+			if quotient*tt.b+remainder != tt.a {
+				t.Errorf("DivMod(%d, %d): %d*%d + %d != %d", tt.a, tt.b, quotient, tt.b, remainder, tt.a)
+			}
+		})
+	}
+}
+
+func TestDivMod_DivisionByZeroSentinel(t *testing.T) {
+	if _, _, err := DivMod(1, 0); !errors.Is(err, ErrDivByZero) {
+		t.Errorf("DivMod(1, 0) error = %v, want %v", err, ErrDivByZero)
+	}
+}
+
+func TestCheckedIntArithmetic(t *testing.T) {
+	tests := []struct {
+		name     string
+		op       func(a, b int) (int, error)
+		a, b     int
+		expected int
+		wantErr  bool
+	}{
+		{name: "add_small", op: AddInt, a: 2, b: 3, expected: 5},
+		{name: "add_to_max", op: AddInt, a: math.MaxInt - 1, b: 1, expected: math.MaxInt},
+		{name: "add_to_min", op: AddInt, a: math.MinInt + 1, b: -1, expected: math.MinInt},
+		{name: "add_opposite_signs", op: AddInt, a: math.MaxInt, b: math.MinInt, expected: -1},
+		{name: "add_past_max", op: AddInt, a: math.MaxInt, b: 1, wantErr: true},
+		{name: "add_past_min", op: AddInt, a: math.MinInt, b: -1, wantErr: true},
+		{name: "sub_small", op: SubInt, a: 2, b: 5, expected: -3},
+		{name: "sub_to_max", op: SubInt, a: math.MaxInt - 1, b: -1, expected: math.MaxInt},
+		{name: "sub_to_min", op: SubInt, a: math.MinInt + 1, b: 1, expected: math.MinInt},
+		{name: "sub_past_max", op: SubInt, a: math.MaxInt, b: -1, wantErr: true},
+		{name: "sub_past_min", op: SubInt, a: math.MinInt, b: 1, wantErr: true},
+		{name: "sub_min_int", op: SubInt, a: 0, b: math.MinInt, wantErr: true},
+		{name: "mul_small", op: MulInt, a: -4, b: 5, expected: -20},
+		{name: "mul_by_zero", op: MulInt, a: math.MaxInt, b: 0, expected: 0},
+		{name: "mul_near_max", op: MulInt, a: math.MaxInt / 2, b: 2, expected: math.MaxInt - 1},
+		{name: "mul_to_min", op: MulInt, a: math.MinInt / 2, b: 2, expected: math.MinInt},
+		{name: "mul_past_max", op: MulInt, a: math.MaxInt/2 + 1, b: 2, wantErr: true},
+		{name: "mul_large_factors", op: MulInt, a: 3037000500, b: 3037000500, wantErr: true},
+		{name: "mul_min_int_by_negative_one", op: MulInt, a: math.MinInt, b: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.op(tt.a, tt.b)
+			if tt.wantErr {
+				if !errors.Is(err, ErrIntegerOverflow) {
+					t.Errorf("op(%d, %d) = %d, error = %v, want %v", tt.a, tt.b, result, err, ErrIntegerOverflow)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("op(%d, %d) unexpected error: %v", tt.a, tt.b, err)
+			}
+			if result != tt.expected {
+				t.Errorf("op(%d, %d) = %d, want %d", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
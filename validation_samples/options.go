@@ -0,0 +1,37 @@
+package main
+
+// Option configures a Calculator built by NewCalculatorWithOptions
+type Option func(*Calculator)
+
+// NewCalculatorWithOptions creates a calculator with the same defaults as
+// NewCalculator, then applies opts in order
+func NewCalculatorWithOptions(opts ...Option) *Calculator {
+	c := NewCalculator()
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithMaxHistory limits history to the n most recent entries, like
+// SetMaxHistory. Zero or a negative n means unlimited.
+func WithMaxHistory(n int) Option {
+	return func(c *Calculator) {
+		c.MaxHistory = MaxOf(n, 0)
+	}
+}
+
+// WithAngleMode sets how Sin, Cos and Tan interpret their input
+func WithAngleMode(mode AngleMode) Option {
+	return func(c *Calculator) {
+		c.AngleMode = mode
+	}
+}
+
+// WithPrecision sets the number of decimal places shown in history entries
+// and by FormatResult, like SetPrecision. A negative p is treated as zero.
+func WithPrecision(p int) Option {
+	return func(c *Calculator) {
+		c.Precision = MaxOf(p, 0)
+	}
+}
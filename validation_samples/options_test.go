@@ -0,0 +1,75 @@
+package main
+
+import "testing"
+
+func TestNewCalculatorWithOptions_Defaults(t *testing.T) {
+	got := NewCalculatorWithOptions()
+	want := NewCalculator()
+
+	if got.MaxHistory != want.MaxHistory {
+		t.Errorf("MaxHistory = %d, want %d", got.MaxHistory, want.MaxHistory)
+	}
+	if got.AngleMode != want.AngleMode {
+		t.Errorf("AngleMode = %v, want %v", got.AngleMode, want.AngleMode)
+	}
+	if got.Precision != want.Precision {
+		t.Errorf("Precision = %d, want %d", got.Precision, want.Precision)
+	}
+	if got.Result != 0 || got.History == nil || len(got.History) != 0 {
+		t.Errorf("Result = %v, History = %v, want 0 and an empty history", got.Result, got.History)
+	}
+}
+
+func TestNewCalculatorWithOptions(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []Option
+		maxHist   int
+		angleMode AngleMode
+		precision int
+	}{
+		{name: "max_history", opts: []Option{WithMaxHistory(5)}, maxHist: 5, precision: 2},
+		{name: "negative_max_history", opts: []Option{WithMaxHistory(-3)}, maxHist: 0, precision: 2},
+		{name: "angle_mode", opts: []Option{WithAngleMode(Degrees)}, angleMode: Degrees, precision: 2},
+		{name: "precision", opts: []Option{WithPrecision(4)}, precision: 4},
+		{name: "negative_precision", opts: []Option{WithPrecision(-1)}, precision: 0},
+		{name: "combined", opts: []Option{WithMaxHistory(10), WithAngleMode(Degrees), WithPrecision(6)}, maxHist: 10, angleMode: Degrees, precision: 6},
+		{name: "last_wins", opts: []Option{WithPrecision(3), WithPrecision(1)}, precision: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculatorWithOptions(tt.opts...)
+			if calc.MaxHistory != tt.maxHist {
+				t.Errorf("MaxHistory = %d, want %d", calc.MaxHistory, tt.maxHist)
+			}
+			if calc.AngleMode != tt.angleMode {
+				t.Errorf("AngleMode = %v, want %v", calc.AngleMode, tt.angleMode)
+			}
+			if calc.Precision != tt.precision {
+				t.Errorf("Precision = %d, want %d", calc.Precision, tt.precision)
+			}
+		})
+	}
+}
+
+func TestNewCalculatorWithOptions_Behavior(t *testing.T) {
+	calc := NewCalculatorWithOptions(WithMaxHistory(2), WithAngleMode(Degrees))
+
+	result, err := calc.Sin(90)
+	if err != nil {
+		t.Fatalf("Sin(90) unexpected error: %v", err)
+	}
+	if result != 1 {
+		t.Errorf("Sin(90) in degree mode = %v, want 1", result)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := calc.Add(float64(i), 1); err != nil {
+			t.Fatalf("Add(%d, 1) unexpected error: %v", i, err)
+		}
+	}
+	if got := len(calc.GetHistory()); got != 2 {
+		t.Errorf("len(GetHistory()) = %d, want 2", got)
+	}
+}
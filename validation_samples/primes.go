@@ -0,0 +1,195 @@
+package main
+
+import (
+	"crypto/rand"
+	"errors"
+	"math"
+	"math/big"
+)
+
+// PrimesUpTo returns all primes less than or equal to n using the Sieve of
+// Eratosthenes. Only odd numbers are sieved, so memory use is about n/2 bytes.
+func PrimesUpTo(n int) []int {
+	if n < 2 {
+		return []int{}
+	}
+
+	// composite[k] reports whether 2k+1 is composite
+	composite := make([]bool, (n+1)/2)
+	for i := 3; i*i <= n; i += 2 {
+		if composite[i/2] {
+			continue
+		}
+		for j := i * i; j <= n; j += 2 * i {
+			composite[j/2] = true
+		}
+	}
+
+	primes := make([]int, 0, estimatePrimeCount(n))
+	primes = append(primes, 2)
+	for k := 1; k < len(composite); k++ {
+		if !composite[k] {
+			primes = append(primes, 2*k+1)
+		}
+	}
+	return primes
+}
+
+// estimatePrimeCount returns an upper bound on the number of primes <= n,
+// used to size the result slice up front
+func estimatePrimeCount(n int) int {
+	if n < 17 {
+		return 6
+	}
+
+	x := float64(n)
+	return int(1.25506 * x / math.Log(x))
+}
+
+// PrimeFactors returns the prime factors of n in ascending order, repeated
+// according to multiplicity (12 yields [2 2 3])
+func PrimeFactors(n int) ([]int, error) {
+	if n < 2 {
+		return nil, errors.New("input must be at least 2")
+	}
+
+	factors := make([]int, 0)
+	for n%2 == 0 {
+		factors = append(factors, 2)
+		n /= 2
+	}
+
+	for p := 3; p <= n/p; p += 2 {
+		for n%p == 0 {
+			factors = append(factors, p)
+			n /= p
+		}
+	}
+
+	if n > 1 {
+		factors = append(factors, n)
+	}
+	return factors, nil
+}
+
+// PrimeFactorization returns the prime factorization of n as a map from each
+// prime factor to its exponent (12 yields {2: 2, 3: 1})
+func PrimeFactorization(n int) (map[int]int, error) {
+	factors, err := PrimeFactors(n)
+	if err != nil {
+		return nil, err
+	}
+
+	exponents := make(map[int]int)
+	for _, p := range factors {
+		exponents[p]++
+	}
+	return exponents, nil
+}
+
+// NextPrime returns the smallest prime strictly greater than n
+func NextPrime(n int) int {
+	if n < 2 {
+		return 2
+	}
+
+	candidate := n + 1
+	if candidate%2 == 0 && candidate != 2 {
+		candidate++
+	}
+	for !IsPrime(candidate) {
+		candidate += 2
+	}
+	return candidate
+}
+
+// NthPrime returns the kth prime, counting from NthPrime(1) == 2
+func NthPrime(k int) (int, error) {
+	if k < 1 {
+		return 0, errors.New("k must be at least 1")
+	}
+
+	p := 2
+	for i := 1; i < k; i++ {
+		p = NextPrime(p)
+	}
+	return p, nil
+}
+
+// millerRabinBases are the witnesses that make Miller-Rabin deterministic for
+// every n < 2^64
+var millerRabinBases = []int64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+// millerRabinExtraRounds is the number of random witnesses added for n >= 2^64,
+// bounding the error probability by 4^-20
+const millerRabinExtraRounds = 20
+
+// IsPrimeBig reports whether n is prime using the Miller-Rabin test. The
+// result is exact for n < 2^64; above that it is probabilistic.
+func IsPrimeBig(n *big.Int) bool {
+	if n == nil || n.Sign() <= 0 {
+		return false
+	}
+
+	if n.IsInt64() && n.Int64() < 2 {
+		return false
+	}
+
+	for _, p := range millerRabinBases {
+		bp := big.NewInt(p)
+		if n.Cmp(bp) == 0 {
+			return true
+		}
+		if new(big.Int).Mod(n, bp).Sign() == 0 {
+			return false
+		}
+	}
+
+	// Write n-1 as d * 2^s with d odd
+	one := big.NewInt(1)
+	nMinusOne := new(big.Int).Sub(n, one)
+	s := nMinusOne.TrailingZeroBits()
+	d := new(big.Int).Rsh(nMinusOne, s)
+
+	for _, a := range millerRabinBases {
+		if !millerRabinRound(n, nMinusOne, d, s, big.NewInt(a)) {
+			return false
+		}
+	}
+
+	if n.BitLen() <= 64 {
+		return true
+	}
+
+	// Random witnesses in [2, n-2]
+	limit := new(big.Int).Sub(n, big.NewInt(3))
+	for i := 0; i < millerRabinExtraRounds; i++ {
+		a, err := rand.Int(rand.Reader, limit)
+		if err != nil {
+			// Fall back to the standard library's own probabilistic test
+			return n.ProbablyPrime(millerRabinExtraRounds)
+		}
+		a.Add(a, big.NewInt(2))
+		if !millerRabinRound(n, nMinusOne, d, s, a) {
+			return false
+		}
+	}
+	return true
+}
+
+// millerRabinRound reports whether n passes a single Miller-Rabin round for
+// witness a, where n-1 = d * 2^s
+func millerRabinRound(n, nMinusOne, d *big.Int, s uint, a *big.Int) bool {
+	x := new(big.Int).Exp(a, d, n)
+	if x.Cmp(big.NewInt(1)) == 0 || x.Cmp(nMinusOne) == 0 {
+		return true
+	}
+
+	for r := uint(1); r < s; r++ {
+		x.Mul(x, x).Mod(x, n)
+		if x.Cmp(nMinusOne) == 0 {
+			return true
+		}
+	}
+	return false
+}
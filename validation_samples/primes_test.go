@@ -0,0 +1,298 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestPrimesUpTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected []int
+	}{
+		{name: "negative", n: -10, expected: []int{}},
+		{name: "zero", n: 0, expected: []int{}},
+		{name: "one", n: 1, expected: []int{}},
+		{name: "two", n: 2, expected: []int{2}},
+		{name: "three", n: 3, expected: []int{2, 3}},
+		{name: "ten", n: 10, expected: []int{2, 3, 5, 7}},
+		{name: "prime_bound_inclusive", n: 29, expected: []int{2, 3, 5, 7, 11, 13, 17, 19, 23, 29}},
+		{name: "square_bound", n: 49, expected: []int{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := PrimesUpTo(tt.n)
+			if result == nil {
+				t.Fatalf("PrimesUpTo(%d) = nil, want empty slice", tt.n)
+			}
+			if len(result) != len(tt.expected) {
+				t.Fatalf("PrimesUpTo(%d) = %v, want %v", tt.n, result, tt.expected)
+			}
+			for i := range tt.expected {
+				if result[i] != tt.expected[i] {
+					t.Errorf("PrimesUpTo(%d)[%d] = %d, want %d", tt.n, i, result[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPrimesUpTo_MatchesIsPrime(t *testing.T) {
+	const n = 10000
+	primes := PrimesUpTo(n)
+
+	var want []int
+	for i := 0; i <= n; i++ {
+		if IsPrime(i) {
+			want = append(want, i)
+		}
+	}
+
+	if len(primes) != len(want) {
+		t.Fatalf("len(PrimesUpTo(%d)) = %d, want %d", n, len(primes), len(want))
+	}
+	for i := range want {
+		if primes[i] != want[i] {
+			t.Fatalf("PrimesUpTo(%d)[%d] = %d, want %d", n, i, primes[i], want[i])
+		}
+	}
+}
+
+func TestPrimesUpTo_Large(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping large sieve in short mode")
+	}
+
+	// pi(10^7) = 664579
+	if got := len(PrimesUpTo(10_000_000)); got != 664579 {
+		t.Errorf("len(PrimesUpTo(1e7)) = %d, want 664579", got)
+	}
+}
+
+func BenchmarkPrimesUpTo(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		PrimesUpTo(100_000)
+	}
+}
+
+func BenchmarkPrimesUpTo_IsPrimeLoop(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		primes := make([]int, 0)
+		for n := 0; n <= 100_000; n++ {
+			if IsPrime(n) {
+				primes = append(primes, n)
+			}
+		}
+	}
+}
+
+func TestPrimeFactors(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected []int
+		wantErr  bool
+	}{
+		{name: "smallest_prime", n: 2, expected: []int{2}},
+		{name: "odd_prime", n: 97, expected: []int{97}},
+		{name: "composite", n: 12, expected: []int{2, 2, 3}},
+		{name: "power_of_two", n: 1024, expected: []int{2, 2, 2, 2, 2, 2, 2, 2, 2, 2}},
+		{name: "odd_prime_power", n: 243, expected: []int{3, 3, 3, 3, 3}},
+		{name: "square_of_prime", n: 49, expected: []int{7, 7}},
+		{name: "large_semiprime", n: 999_983 * 1_000_003, expected: []int{999_983, 1_000_003}},
+		{name: "one", n: 1, wantErr: true},
+		{name: "zero", n: 0, wantErr: true},
+		{name: "negative", n: -12, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := PrimeFactors(tt.n)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("PrimeFactors(%d) expected error but got none", tt.n)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("PrimeFactors(%d) unexpected error: %v", tt.n, err)
+			}
+			if len(result) != len(tt.expected) {
+				t.Fatalf("PrimeFactors(%d) = %v, want %v", tt.n, result, tt.expected)
+			}
+			for i := range tt.expected {
+				if result[i] != tt.expected[i] {
+					t.Errorf("PrimeFactors(%d) = %v, want %v", tt.n, result, tt.expected)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestPrimeFactorization(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected map[int]int
+		wantErr  bool
+	}{
+		{name: "prime", n: 13, expected: map[int]int{13: 1}},
+		{name: "composite", n: 12, expected: map[int]int{2: 2, 3: 1}},
+		{name: "prime_power", n: 3125, expected: map[int]int{5: 5}},
+		{name: "many_factors", n: 360, expected: map[int]int{2: 3, 3: 2, 5: 1}},
+		{name: "large_semiprime", n: 999_983 * 1_000_003, expected: map[int]int{999_983: 1, 1_000_003: 1}},
+		{name: "one", n: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := PrimeFactorization(tt.n)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("PrimeFactorization(%d) expected error but got none", tt.n)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("PrimeFactorization(%d) unexpected error: %v", tt.n, err)
+			}
+			if len(result) != len(tt.expected) {
+				t.Fatalf("PrimeFactorization(%d) = %v, want %v", tt.n, result, tt.expected)
+			}
+			for p, exp := range tt.expected {
+				if result[p] != exp {
+					t.Errorf("PrimeFactorization(%d)[%d] = %d, want %d", tt.n, p, result[p], exp)
+				}
+			}
+		})
+	}
+}
+
+func TestNextPrime(t *testing.T) {
+	tests := []struct {
+		n        int
+		expected int
+	}{
+		{n: -5, expected: 2},
+		{n: 0, expected: 2},
+		{n: 1, expected: 2},
+		{n: 2, expected: 3},
+		{n: 3, expected: 5},
+		{n: 13, expected: 17},
+		{n: 14, expected: 17},
+		{n: 89, expected: 97},
+		{n: 7919, expected: 7927},
+	}
+
+	for _, tt := range tests {
+		if got := NextPrime(tt.n); got != tt.expected {
+			t.Errorf("NextPrime(%d) = %d, want %d", tt.n, got, tt.expected)
+		}
+	}
+}
+
+func TestNthPrime(t *testing.T) {
+	tests := []struct {
+		name     string
+		k        int
+		expected int
+		wantErr  bool
+	}{
+		{name: "first", k: 1, expected: 2},
+		{name: "second", k: 2, expected: 3},
+		{name: "tenth", k: 10, expected: 29},
+		{name: "hundredth", k: 100, expected: 541},
+		{name: "thousandth", k: 1000, expected: 7919},
+		{name: "zero", k: 0, wantErr: true},
+		{name: "negative", k: -3, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NthPrime(tt.k)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("NthPrime(%d) expected error but got none", tt.k)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("NthPrime(%d) unexpected error: %v", tt.k, err)
+			}
+			if result != tt.expected {
+				t.Errorf("NthPrime(%d) = %d, want %d", tt.k, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsPrimeBig(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        string
+		expected bool
+	}{
+		{name: "zero", n: "0", expected: false},
+		{name: "one", n: "1", expected: false},
+		{name: "negative_prime", n: "-7", expected: false},
+		{name: "two", n: "2", expected: true},
+		{name: "witness_prime", n: "37", expected: true},
+		{name: "small_composite", n: "91", expected: false},
+		{name: "mersenne_prime_61", n: "2305843009213693951", expected: true},
+		{name: "largest_uint64_prime", n: "18446744073709551557", expected: true},
+		{name: "max_uint64", n: "18446744073709551615", expected: false},
+		{name: "mersenne_prime_89", n: "618970019642690137449562111", expected: true},
+		{name: "mersenne_prime_127", n: "170141183460469231731687303715884105727", expected: true},
+		{name: "mersenne_composite_67", n: "147573952589676412927", expected: false},
+		{name: "large_semiprime", n: "998244359987710471", expected: false},
+		{name: "product_of_largest_uint32_primes", n: "18446743979220271189", expected: false},
+		{name: "strong_pseudoprime_to_bases_up_to_37", n: "318665857834031151167461", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, ok := new(big.Int).SetString(tt.n, 10)
+			if !ok {
+				t.Fatalf("invalid test input %q", tt.n)
+			}
+			if got := IsPrimeBig(n); got != tt.expected {
+				t.Errorf("IsPrimeBig(%s) = %t, want %t", tt.n, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsPrimeBig_CarmichaelNumbers(t *testing.T) {
+	// Carmichael numbers fool the Fermat test for every coprime base
+	carmichaels := []string{"561", "1105", "1729", "2465", "2821", "6601", "8911", "41041", "825265", "321197185", "3825123056546413051"}
+
+	for _, c := range carmichaels {
+		n, _ := new(big.Int).SetString(c, 10)
+		if IsPrimeBig(n) {
+			t.Errorf("IsPrimeBig(%s) = true, want false for Carmichael number", c)
+		}
+	}
+}
+
+func TestIsPrimeBig_Nil(t *testing.T) {
+	if IsPrimeBig(nil) {
+		t.Errorf("IsPrimeBig(nil) = true, want false")
+	}
+}
+
+func TestIsPrimeBig_MatchesIsPrime(t *testing.T) {
+	for i := 0; i <= 5000; i++ {
+		if got, want := IsPrimeBig(big.NewInt(int64(i))), IsPrime(i); got != want {
+			t.Fatalf("IsPrimeBig(%d) = %t, want %t", i, got, want)
+		}
+	}
+}
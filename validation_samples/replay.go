@@ -0,0 +1,155 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// replayNumber matches a number as written in a history entry
+const replayNumber = `(-?\d+(?:\.\d+)?)`
+
+var (
+	replayBinaryRegex   = regexp.MustCompile(`^` + replayNumber + ` ([-+*/^%]) ` + replayNumber + `$`)
+	replayUnaryRegex    = regexp.MustCompile(`^(sqrt|abs|neg|ln|log10|sin|cos|tan)\(` + replayNumber + `\)$`)
+	replayRoundRegex    = regexp.MustCompile(`^(round|floor|ceil)\(` + replayNumber + `, (-?\d+)\)$`)
+	replayLogBaseRegex  = regexp.MustCompile(`^log\(` + replayNumber + `, base ` + replayNumber + `\)$`)
+	replayPercentRegex  = regexp.MustCompile(`^` + replayNumber + `% of ` + replayNumber + `$`)
+	replayChangeRegex   = regexp.MustCompile(`^` + replayNumber + ` -> ` + replayNumber + `$`)
+	replayDecimalsRegex = regexp.MustCompile(`^-?\d+(?:\.(\d+))?$`)
+)
+
+// Replay re-executes each history entry, such as "10.00 + 5.00 = 15.00",
+// through the matching Calculator method and checks that it reproduces the
+// recorded result at the precision it was written with. Entries that aren't
+// produced by a named operation are evaluated with Eval. On success the
+// replayed entries are appended to the history and the final Result is
+// returned; if any entry is malformed, fails, or gives a different result,
+// the calculator is left unchanged.
+//
+// Operands are replayed as written, so entries whose inputs had more
+// decimals than the history shows may not reproduce exactly.
+func (c *Calculator) Replay(history []string) (float64, error) {
+	c.Lock()
+	scratch := NewCalculator()
+	scratch.AngleMode = c.AngleMode
+	scratch.Precision = c.Precision
+	scratch.HistoryFormatter = c.HistoryFormatter
+	c.Unlock()
+
+	results := make([]float64, len(history))
+	for i, entry := range history {
+		operation, recorded, ok := splitHistoryEntry(entry)
+		if !ok {
+			return 0, fmt.Errorf("history entry %d: malformed entry %q", i+1, entry)
+		}
+
+		match := replayDecimalsRegex.FindStringSubmatch(recorded)
+		if match == nil {
+			return 0, fmt.Errorf("history entry %d: malformed result in %q", i+1, entry)
+		}
+
+		result, err := scratch.replayOperation(operation)
+		if err != nil {
+			return 0, fmt.Errorf("history entry %d: %q: %w", i+1, entry, err)
+		}
+
+		if got := strconv.FormatFloat(result, 'f', len(match[1]), 64); got != recorded {
+			return 0, fmt.Errorf("history entry %d: %q replays to %s", i+1, entry, got)
+		}
+		results[i] = result
+	}
+
+	replayed := scratch.GetHistory()
+
+	c.Lock()
+	defer c.Unlock()
+
+	for i, entry := range replayed {
+		c.record(entry, results[i])
+	}
+	return c.Result, nil
+}
+
+// replayOperation runs the operation part of a history entry
+func (c *Calculator) replayOperation(operation string) (float64, error) {
+	if m := replayBinaryRegex.FindStringSubmatch(operation); m != nil {
+		a, b := parseReplayNumber(m[1]), parseReplayNumber(m[3])
+		switch m[2] {
+		case "+":
+			return c.Add(a, b)
+		case "-":
+			return c.Subtract(a, b)
+		case "*":
+			return c.Multiply(a, b)
+		case "/":
+			return c.Divide(a, b)
+		case "^":
+			return c.Power(a, b)
+		case "%":
+			return c.Modulo(a, b)
+		}
+	}
+
+	if m := replayUnaryRegex.FindStringSubmatch(operation); m != nil {
+		x := parseReplayNumber(m[2])
+		switch m[1] {
+		case "sqrt":
+			return c.SquareRoot(x)
+		case "abs":
+			return c.Abs(x)
+		case "neg":
+			return c.Negate(x)
+		case "ln":
+			return c.Ln(x)
+		case "log10":
+			return c.Log10(x)
+		case "sin":
+			return c.Sin(x)
+		case "cos":
+			return c.Cos(x)
+		case "tan":
+			return c.Tan(x)
+		}
+	}
+
+	if m := replayRoundRegex.FindStringSubmatch(operation); m != nil {
+		x := parseReplayNumber(m[2])
+		places, err := strconv.Atoi(m[3])
+		if err != nil {
+			return 0, fmt.Errorf("invalid decimal places %q", m[3])
+		}
+		switch m[1] {
+		case "round":
+			return c.Round(x, places)
+		case "floor":
+			return c.Floor(x, places)
+		case "ceil":
+			return c.Ceil(x, places)
+		}
+	}
+
+	if m := replayLogBaseRegex.FindStringSubmatch(operation); m != nil {
+		return c.LogBase(parseReplayNumber(m[1]), parseReplayNumber(m[2]))
+	}
+
+	if m := replayPercentRegex.FindStringSubmatch(operation); m != nil {
+		return c.Percentage(parseReplayNumber(m[2]), parseReplayNumber(m[1]))
+	}
+
+	if m := replayChangeRegex.FindStringSubmatch(operation); m != nil {
+		return c.PercentChange(parseReplayNumber(m[1]), parseReplayNumber(m[2]))
+	}
+
+	if strings.HasPrefix(operation, "sum(") {
+		return 0, fmt.Errorf("cannot replay %q: the summed values are not recorded", operation)
+	}
+	return c.Eval(operation)
+}
+
+// parseReplayNumber parses a number the replay regexes have already matched
+func parseReplayNumber(s string) float64 {
+	v, _ := strconv.ParseFloat(s, 64)
+	return v
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCalculatorReplay(t *testing.T) {
+	original := NewCalculator()
+	steps := []func() (float64, error){
+		func() (float64, error) { return original.Add(10, 5) },
+		func() (float64, error) { return original.Subtract(-5, -3) },
+		func() (float64, error) { return original.Multiply(3, 4) },
+		func() (float64, error) { return original.Divide(10, 4) },
+		func() (float64, error) { return original.Power(2, 10) },
+		func() (float64, error) { return original.Modulo(10, 3) },
+		func() (float64, error) { return original.SquareRoot(16) },
+		func() (float64, error) { return original.Abs(-2.5) },
+		func() (float64, error) { return original.Negate(7) },
+		func() (float64, error) { return original.Ln(1) },
+		func() (float64, error) { return original.Log10(1000) },
+		func() (float64, error) { return original.LogBase(8, 2) },
+		func() (float64, error) { return original.Round(2.75, 1) },
+		func() (float64, error) { return original.Percentage(200, 12.5) },
+		func() (float64, error) { return original.PercentChange(50, 75) },
+		func() (float64, error) { return original.Eval("(2 + 3) * 4") },
+	}
+	for i, step := range steps {
+		if _, err := step(); err != nil {
+			t.Fatalf("step %d unexpected error: %v", i, err)
+		}
+	}
+
+	calc := NewCalculator()
+	result, err := calc.Replay(original.GetHistory())
+	if err != nil {
+		t.Fatalf("Replay() unexpected error: %v", err)
+	}
+
+	if result != original.CurrentResult() {
+		t.Errorf("Replay() = %v, want %v", result, original.CurrentResult())
+	}
+	if got := calc.CurrentResult(); got != result {
+		t.Errorf("CurrentResult() after Replay() = %v, want %v", got, result)
+	}
+	if got, want := calc.GetHistory(), original.GetHistory(); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetHistory() after Replay() = %q, want %q", got, want)
+	}
+}
+
+func TestCalculatorReplay_DegreeMode(t *testing.T) {
+	calc := NewCalculator()
+	calc.SetAngleMode(Degrees)
+
+	result, err := calc.Replay([]string{"sin(30.00) = 0.50", "cos(90.00) = 0.00"})
+	if err != nil {
+		t.Fatalf("Replay() unexpected error: %v", err)
+	}
+	if result != 0 {
+		t.Errorf("Replay() = %v, want 0", result)
+	}
+}
+
+func TestCalculatorReplay_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		history []string
+		wantErr string
+	}{
+		{name: "tampered_result", history: []string{"10.00 + 5.00 = 15.00", "3.00 * 4.00 = 13.00"}, wantErr: "history entry 2"},
+		{name: "tampered_operand", history: []string{"10.00 + 6.00 = 15.00"}, wantErr: "replays to 16.00"},
+		{name: "no_result", history: []string{"10.00 + 5.00"}, wantErr: "malformed entry"},
+		{name: "non_numeric_result", history: []string{"10.00 + 5.00 = fifteen"}, wantErr: "malformed result"},
+		{name: "unknown_operation", history: []string{"frobnicate(2.00) = 4.00"}, wantErr: "history entry 1"},
+		{name: "operation_fails", history: []string{"1.00 / 0.00 = 0.00"}, wantErr: ErrDivByZero.Error()},
+		{name: "sum_not_replayable", history: []string{"sum(3 values) = 6.00"}, wantErr: "not recorded"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			if _, err := calc.Add(1, 1); err != nil {
+				t.Fatalf("Add(1, 1) unexpected error: %v", err)
+			}
+
+			_, err := calc.Replay(tt.history)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Replay(%q) error = %v, want %q", tt.history, err, tt.wantErr)
+			}
+
+			if got := calc.CurrentResult(); got != 2 {
+				t.Errorf("CurrentResult() after failed Replay() = %v, want 2", got)
+			}
+			if got := calc.GetHistory(); len(got) != 1 {
+				t.Errorf("GetHistory() after failed Replay() = %q, want the original entry only", got)
+			}
+		})
+	}
+}
+
+func TestCalculatorReplay_Empty(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.Multiply(6, 7); err != nil {
+		t.Fatalf("Multiply(6, 7) unexpected error: %v", err)
+	}
+
+	result, err := calc.Replay(nil)
+	if err != nil {
+		t.Fatalf("Replay(nil) unexpected error: %v", err)
+	}
+	if result != 42 {
+		t.Errorf("Replay(nil) = %v, want the current result 42", result)
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// romanNumerals lists each Roman numeral symbol, including the subtractive
+// pairs, from largest to smallest value
+var romanNumerals = []struct {
+	value  int
+	symbol string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"},
+	{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"},
+	{10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+// ToRoman converts n, between 1 and 3999, to a Roman numeral such as
+// "MCMXCIV"
+func ToRoman(n int) (string, error) {
+	if n < 1 || n > 3999 {
+		return "", errors.New("number must be between 1 and 3999")
+	}
+
+	var b strings.Builder
+	for _, r := range romanNumerals {
+		for n >= r.value {
+			b.WriteString(r.symbol)
+			n -= r.value
+		}
+	}
+	return b.String(), nil
+}
+
+// FromRoman parses a Roman numeral in either case. Only the standard form
+// ToRoman produces is accepted, so sequences such as "IIII", "VV" or "IC"
+// are rejected.
+func FromRoman(s string) (int, error) {
+	numeral := strings.ToUpper(s)
+
+	n, rest := 0, numeral
+	for _, r := range romanNumerals {
+		// No symbol can repeat more than three times in standard form
+		for i := 0; i < 3 && strings.HasPrefix(rest, r.symbol); i++ {
+			n += r.value
+			rest = rest[len(r.symbol):]
+		}
+	}
+
+	if n == 0 || rest != "" {
+		return 0, fmt.Errorf("invalid Roman numeral %q", s)
+	}
+
+	// The greedy parse accepts some non-standard orderings such as "IXI";
+	// round-tripping through ToRoman rejects them
+	if canonical, _ := ToRoman(n); canonical != numeral {
+		return 0, fmt.Errorf("invalid Roman numeral %q", s)
+	}
+	return n, nil
+}
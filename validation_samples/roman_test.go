@@ -0,0 +1,100 @@
+package main
+
+import "testing"
+
+func TestToRoman(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected string
+		wantErr  bool
+	}{
+		{name: "one", n: 1, expected: "I"},
+		{name: "four", n: 4, expected: "IV"},
+		{name: "nine", n: 9, expected: "IX"},
+		{name: "fourteen", n: 14, expected: "XIV"},
+		{name: "forty", n: 40, expected: "XL"},
+		{name: "ninety_nine", n: 99, expected: "XCIX"},
+		{name: "nineteen_ninety_four", n: 1994, expected: "MCMXCIV"},
+		{name: "max", n: 3999, expected: "MMMCMXCIX"},
+		{name: "zero", n: 0, wantErr: true},
+		{name: "negative", n: -5, wantErr: true},
+		{name: "too_large", n: 4000, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ToRoman(tt.n)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ToRoman(%d) = %q, want error", tt.n, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ToRoman(%d) unexpected error: %v", tt.n, err)
+			}
+			if result != tt.expected {
+				t.Errorf("ToRoman(%d) = %q, want %q", tt.n, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFromRoman(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		expected int
+		wantErr  bool
+	}{
+		{name: "one", s: "I", expected: 1},
+		{name: "three", s: "III", expected: 3},
+		{name: "subtractive", s: "XLII", expected: 42},
+		{name: "nineteen_ninety_four", s: "MCMXCIV", expected: 1994},
+		{name: "lowercase", s: "mmxxiv", expected: 2024},
+		{name: "max", s: "MMMCMXCIX", expected: 3999},
+		{name: "four_ones", s: "IIII", wantErr: true},
+		{name: "repeated_five", s: "VV", wantErr: true},
+		{name: "invalid_subtraction", s: "IC", wantErr: true},
+		{name: "subtract_five", s: "VX", wantErr: true},
+		{name: "out_of_order", s: "IXI", wantErr: true},
+		{name: "four_thousands", s: "MMMM", wantErr: true},
+		{name: "unknown_symbol", s: "XIZ", wantErr: true},
+		{name: "empty", s: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := FromRoman(tt.s)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("FromRoman(%q) = %d, want error", tt.s, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromRoman(%q) unexpected error: %v", tt.s, err)
+			}
+			if result != tt.expected {
+				t.Errorf("FromRoman(%q) = %d, want %d", tt.s, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRomanRoundTrip(t *testing.T) {
+	for n := 1; n <= 3999; n++ {
+		s, err := ToRoman(n)
+		if err != nil {
+			t.Fatalf("ToRoman(%d) unexpected error: %v", n, err)
+		}
+		got, err := FromRoman(s)
+		if err != nil {
+			t.Fatalf("FromRoman(%q) unexpected error: %v", s, err)
+		}
+		if got != n {
+			t.Fatalf("FromRoman(ToRoman(%d)) = %d", n, got)
+		}
+	}
+}
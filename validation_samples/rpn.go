@@ -0,0 +1,69 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// EvalRPN evaluates a reverse Polish notation expression, such as
+// ["2", "3", "+", "4", "*"] for (2 + 3) * 4, using the operators + - * /.
+// The tokens and value are recorded in History.
+func (c *Calculator) EvalRPN(tokens []string) (float64, error) {
+	if len(tokens) == 0 {
+		return 0, errors.New("empty expression")
+	}
+
+	stack := make([]float64, 0, len(tokens))
+	for i, tok := range tokens {
+		if len(tok) == 1 && strings.Contains("+-*/", tok) {
+			if len(stack) < 2 {
+				return 0, fmt.Errorf("stack underflow at token %q (index %d)", tok, i)
+			}
+
+			a, b := stack[len(stack)-2], stack[len(stack)-1]
+			stack = stack[:len(stack)-2]
+
+			var result float64
+			switch tok {
+			case "+":
+				result = a + b
+			case "-":
+				result = a - b
+			case "*":
+				result = a * b
+			case "/":
+				if b == 0 {
+					return 0, ErrDivByZero
+				}
+				result = a / b
+			}
+
+			if math.IsInf(result, 0) {
+				return 0, ErrOverflow
+			}
+			stack = append(stack, result)
+			continue
+		}
+
+		value, err := strconv.ParseFloat(tok, 64)
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+			return 0, fmt.Errorf("invalid token %q (index %d)", tok, i)
+		}
+		stack = append(stack, value)
+	}
+
+	if len(stack) != 1 {
+		return 0, fmt.Errorf("malformed expression: %d values left on the stack", len(stack))
+	}
+
+	result := stack[0]
+
+	c.Lock()
+	defer c.Unlock()
+
+	c.record(fmt.Sprintf("%s = %s", strings.Join(tokens, " "), c.formatNumber(result)), result)
+	return result, nil
+}
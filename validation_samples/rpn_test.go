@@ -0,0 +1,80 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCalculatorEvalRPN(t *testing.T) {
+	tests := []struct {
+		name     string
+		tokens   []string
+		expected float64
+	}{
+		{name: "example", tokens: []string{"2", "3", "+", "4", "*"}, expected: 20},
+		{name: "single_number", tokens: []string{"42"}, expected: 42},
+		{name: "operand_order", tokens: []string{"10", "4", "-"}, expected: 6},
+		{name: "division", tokens: []string{"1", "8", "/"}, expected: 0.125},
+		{name: "negative_literal", tokens: []string{"-3", "2", "*"}, expected: -6},
+		{name: "decimals", tokens: []string{"1.5", "2.25", "+"}, expected: 3.75},
+		{name: "nested", tokens: []string{"5", "1", "2", "+", "4", "*", "+", "3", "-"}, expected: 14},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			result, err := calc.EvalRPN(tt.tokens)
+			if err != nil {
+				t.Fatalf("EvalRPN(%q) unexpected error: %v", tt.tokens, err)
+			}
+			if result != tt.expected || calc.Result != tt.expected {
+				t.Errorf("EvalRPN(%q) = %v (Result %v), want %v", tt.tokens, result, calc.Result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculatorEvalRPN_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		tokens  []string
+		wantErr string
+	}{
+		{name: "empty", tokens: nil, wantErr: "empty expression"},
+		{name: "underflow", tokens: []string{"2", "+"}, wantErr: `stack underflow at token "+" (index 1)`},
+		{name: "operator_first", tokens: []string{"*", "2", "3"}, wantErr: `stack underflow at token "*" (index 0)`},
+		{name: "leftover_operands", tokens: []string{"2", "3"}, wantErr: "malformed expression: 2 values left on the stack"},
+		{name: "unknown_operator", tokens: []string{"2", "3", "^"}, wantErr: `invalid token "^" (index 2)`},
+		{name: "not_a_number", tokens: []string{"two"}, wantErr: `invalid token "two" (index 0)`},
+		{name: "nan_literal", tokens: []string{"NaN"}, wantErr: `invalid token "NaN" (index 0)`},
+		{name: "division_by_zero", tokens: []string{"1", "0", "/"}, wantErr: ErrDivByZero.Error()},
+		{name: "overflow", tokens: []string{"1e308", "10", "*"}, wantErr: ErrOverflow.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator()
+			_, err := calc.EvalRPN(tt.tokens)
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("EvalRPN(%q) error = %v, want %q", tt.tokens, err, tt.wantErr)
+			}
+			if len(calc.History) != 0 {
+				t.Errorf("History = %v, want empty after error", calc.History)
+			}
+		})
+	}
+}
+
+func TestCalculatorEvalRPN_History(t *testing.T) {
+	calc := NewCalculator()
+	if _, err := calc.EvalRPN([]string{"2", "3", "+", "4", "*"}); err != nil {
+		t.Fatalf("EvalRPN() unexpected error: %v", err)
+	}
+	if got, want := calc.GetHistory(), "2 3 + 4 * = 20.00"; len(got) != 1 || got[0] != want {
+		t.Errorf("GetHistory() = %q, want [%q]", got, want)
+	}
+
+	if _, err := calc.EvalRPN([]string{"1", "0", "/"}); !errors.Is(err, ErrDivByZero) {
+		t.Errorf("EvalRPN(1 0 /) error = %v, want %v", err, ErrDivByZero)
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// CircleArea calculates the area of a circle, πr²
+func CircleArea(radius float64) (float64, error) {
+	if err := checkDimensions("radius", radius); err != nil {
+		return 0, err
+	}
+
+	return math.Pi * radius * radius, nil
+}
+
+// CircleCircumference calculates the circumference of a circle, 2πr
+func CircleCircumference(radius float64) (float64, error) {
+	if err := checkDimensions("radius", radius); err != nil {
+		return 0, err
+	}
+
+	return 2 * math.Pi * radius, nil
+}
+
+// TriangleArea calculates the area of a triangle from its base and height
+func TriangleArea(base, height float64) (float64, error) {
+	if err := checkDimensions("base and height", base, height); err != nil {
+		return 0, err
+	}
+
+	return base * height / 2, nil
+}
+
+// TriangleAreaHeron calculates the area of a triangle from the lengths of
+// its three sides using Heron's formula. Sides that cannot close into a
+// triangle with positive area, such as 1, 2 and 3, are rejected.
+func TriangleAreaHeron(a, b, c float64) (float64, error) {
+	if err := checkDimensions("sides", a, b, c); err != nil {
+		return 0, err
+	}
+
+	// Kahan's rearrangement of Heron's formula stays accurate for needle
+	// shaped triangles; it needs the sides sorted so that a >= b >= c.
+	sides := []float64{a, b, c}
+	sort.Sort(sort.Reverse(sort.Float64Slice(sides)))
+	a, b, c = sides[0], sides[1], sides[2]
+
+	if c-(a-b) <= 0 {
+		return 0, errors.New("sides do not form a triangle")
+	}
+
+	return math.Sqrt((a+(b+c))*(c-(a-b))*(c+(a-b))*(a+(b-c))) / 4, nil
+}
+
+// TrapezoidArea calculates the area of a trapezoid from its two parallel
+// sides and the height between them
+func TrapezoidArea(a, b, height float64) (float64, error) {
+	if err := checkDimensions("sides and height", a, b, height); err != nil {
+		return 0, err
+	}
+
+	return (a + b) * height / 2, nil
+}
+
+// EllipseArea calculates the area of an ellipse from its semi-major and
+// semi-minor axes, πab
+func EllipseArea(semiMajor, semiMinor float64) (float64, error) {
+	if err := checkDimensions("semi-axes", semiMajor, semiMinor); err != nil {
+		return 0, err
+	}
+
+	return math.Pi * semiMajor * semiMinor, nil
+}
+
+// RegularPolygonArea calculates the area of a regular polygon with the given
+// number of sides, each of length sideLength
+func RegularPolygonArea(sides int, sideLength float64) (float64, error) {
+	if sides < 3 {
+		return 0, errors.New("polygon must have at least 3 sides")
+	}
+
+	if err := checkDimensions("side length", sideLength); err != nil {
+		return 0, err
+	}
+
+	n := float64(sides)
+	return n * sideLength * sideLength / (4 * math.Tan(math.Pi/n)), nil
+}
+
+// SphereVolume calculates the volume of a sphere, 4/3·πr³
+func SphereVolume(radius float64) (float64, error) {
+	if err := checkDimensions("radius", radius); err != nil {
+		return 0, err
+	}
+
+	return 4.0 / 3.0 * math.Pi * radius * radius * radius, nil
+}
+
+// CylinderVolume calculates the volume of a right circular cylinder, πr²h
+func CylinderVolume(radius, height float64) (float64, error) {
+	if err := checkDimensions("radius and height", radius, height); err != nil {
+		return 0, err
+	}
+
+	return math.Pi * radius * radius * height, nil
+}
+
+// BoxVolume calculates the volume of a rectangular box
+func BoxVolume(l, w, h float64) (float64, error) {
+	if err := checkDimensions("length, width and height", l, w, h); err != nil {
+		return 0, err
+	}
+
+	return l * w * h, nil
+}
+
+// checkDimensions validates that every value is a positive number, naming
+// the offending quantity in the error
+func checkDimensions(what string, values ...float64) error {
+	for _, v := range values {
+		if math.IsNaN(v) {
+			return ErrNaN
+		}
+	}
+
+	for _, v := range values {
+		if v <= 0 {
+			return fmt.Errorf("%s must be positive: %w", what, ErrNegative)
+		}
+	}
+	return nil
+}
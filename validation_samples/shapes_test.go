@@ -0,0 +1,366 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+const shapeEpsilon = 1e-9
+
+func TestCircleArea(t *testing.T) {
+	tests := []struct {
+		name     string
+		radius   float64
+		expected float64
+		wantErr  error
+	}{
+		{name: "unit", radius: 1, expected: math.Pi},
+		{name: "radius_two", radius: 2, expected: 4 * math.Pi},
+		{name: "fractional", radius: 0.5, expected: math.Pi / 4},
+		{name: "zero", radius: 0, wantErr: ErrNegative},
+		{name: "negative", radius: -1, wantErr: ErrNegative},
+		{name: "nan", radius: math.NaN(), wantErr: ErrNaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CircleArea(tt.radius)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("CircleArea(%v) error = %v, want %v", tt.radius, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CircleArea(%v) unexpected error: %v", tt.radius, err)
+			}
+			if math.Abs(result-tt.expected) > shapeEpsilon {
+				t.Errorf("CircleArea(%v) = %v, want %v", tt.radius, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCircleCircumference(t *testing.T) {
+	tests := []struct {
+		name     string
+		radius   float64
+		expected float64
+		wantErr  error
+	}{
+		{name: "unit", radius: 1, expected: 2 * math.Pi},
+		{name: "radius_three", radius: 3, expected: 6 * math.Pi},
+		{name: "zero", radius: 0, wantErr: ErrNegative},
+		{name: "negative", radius: -2.5, wantErr: ErrNegative},
+		{name: "nan", radius: math.NaN(), wantErr: ErrNaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CircleCircumference(tt.radius)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("CircleCircumference(%v) error = %v, want %v", tt.radius, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CircleCircumference(%v) unexpected error: %v", tt.radius, err)
+			}
+			if math.Abs(result-tt.expected) > shapeEpsilon {
+				t.Errorf("CircleCircumference(%v) = %v, want %v", tt.radius, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTriangleArea(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     float64
+		height   float64
+		expected float64
+		wantErr  error
+	}{
+		{name: "right_3_4", base: 3, height: 4, expected: 6},
+		{name: "fractional", base: 2.5, height: 2, expected: 2.5},
+		{name: "zero_base", base: 0, height: 4, wantErr: ErrNegative},
+		{name: "negative_height", base: 3, height: -4, wantErr: ErrNegative},
+		{name: "nan", base: math.NaN(), height: 4, wantErr: ErrNaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := TriangleArea(tt.base, tt.height)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("TriangleArea(%v, %v) error = %v, want %v", tt.base, tt.height, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TriangleArea(%v, %v) unexpected error: %v", tt.base, tt.height, err)
+			}
+			if math.Abs(result-tt.expected) > shapeEpsilon {
+				t.Errorf("TriangleArea(%v, %v) = %v, want %v", tt.base, tt.height, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTriangleAreaHeron(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b, c  float64
+		expected float64
+		wantErr  bool
+	}{
+		{name: "right_3_4_5", a: 3, b: 4, c: 5, expected: 6},
+		{name: "right_unordered", a: 5, b: 3, c: 4, expected: 6},
+		{name: "equilateral", a: 2, b: 2, c: 2, expected: math.Sqrt(3)},
+		{name: "isosceles_5_5_6", a: 5, b: 5, c: 6, expected: 12},
+		{name: "degenerate", a: 1, b: 2, c: 3, wantErr: true},
+		{name: "impossible", a: 1, b: 1, c: 10, wantErr: true},
+		{name: "zero_side", a: 0, b: 4, c: 5, wantErr: true},
+		{name: "negative_side", a: 3, b: -4, c: 5, wantErr: true},
+		{name: "nan_side", a: 3, b: 4, c: math.NaN(), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := TriangleAreaHeron(tt.a, tt.b, tt.c)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("TriangleAreaHeron(%v, %v, %v) = %v, want error", tt.a, tt.b, tt.c, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TriangleAreaHeron(%v, %v, %v) unexpected error: %v", tt.a, tt.b, tt.c, err)
+			}
+			if math.Abs(result-tt.expected) > shapeEpsilon {
+				t.Errorf("TriangleAreaHeron(%v, %v, %v) = %v, want %v", tt.a, tt.b, tt.c, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTriangleAreaMethodsAgree(t *testing.T) {
+	heron, err := TriangleAreaHeron(3, 4, 5)
+	if err != nil {
+		t.Fatalf("TriangleAreaHeron(3, 4, 5) unexpected error: %v", err)
+	}
+	base, err := TriangleArea(3, 4)
+	if err != nil {
+		t.Fatalf("TriangleArea(3, 4) unexpected error: %v", err)
+	}
+	if heron != 6 || base != 6 {
+		t.Errorf("TriangleAreaHeron(3, 4, 5) = %v, TriangleArea(3, 4) = %v, want 6", heron, base)
+	}
+}
+
+func TestTrapezoidArea(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     float64
+		height   float64
+		expected float64
+		wantErr  error
+	}{
+		{name: "basic", a: 3, b: 5, height: 4, expected: 16},
+		{name: "rectangle", a: 2, b: 2, height: 3, expected: 6},
+		{name: "zero_side", a: 0, b: 5, height: 4, wantErr: ErrNegative},
+		{name: "negative_height", a: 3, b: 5, height: -1, wantErr: ErrNegative},
+		{name: "nan", a: 3, b: math.NaN(), height: 4, wantErr: ErrNaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := TrapezoidArea(tt.a, tt.b, tt.height)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("TrapezoidArea(%v, %v, %v) error = %v, want %v", tt.a, tt.b, tt.height, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TrapezoidArea(%v, %v, %v) unexpected error: %v", tt.a, tt.b, tt.height, err)
+			}
+			if math.Abs(result-tt.expected) > shapeEpsilon {
+				t.Errorf("TrapezoidArea(%v, %v, %v) = %v, want %v", tt.a, tt.b, tt.height, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEllipseArea(t *testing.T) {
+	tests := []struct {
+		name      string
+		semiMajor float64
+		semiMinor float64
+		expected  float64
+		wantErr   error
+	}{
+		{name: "basic", semiMajor: 3, semiMinor: 2, expected: 6 * math.Pi},
+		{name: "circle", semiMajor: 1, semiMinor: 1, expected: math.Pi},
+		{name: "zero_axis", semiMajor: 3, semiMinor: 0, wantErr: ErrNegative},
+		{name: "negative_axis", semiMajor: -3, semiMinor: 2, wantErr: ErrNegative},
+		{name: "nan", semiMajor: math.NaN(), semiMinor: 2, wantErr: ErrNaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := EllipseArea(tt.semiMajor, tt.semiMinor)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("EllipseArea(%v, %v) error = %v, want %v", tt.semiMajor, tt.semiMinor, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EllipseArea(%v, %v) unexpected error: %v", tt.semiMajor, tt.semiMinor, err)
+			}
+			if math.Abs(result-tt.expected) > shapeEpsilon {
+				t.Errorf("EllipseArea(%v, %v) = %v, want %v", tt.semiMajor, tt.semiMinor, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRegularPolygonArea(t *testing.T) {
+	tests := []struct {
+		name       string
+		sides      int
+		sideLength float64
+		expected   float64
+		wantErr    bool
+	}{
+		{name: "equilateral_triangle", sides: 3, sideLength: 2, expected: math.Sqrt(3)},
+		{name: "square", sides: 4, sideLength: 3, expected: 9},
+		{name: "hexagon", sides: 6, sideLength: 1, expected: 3 * math.Sqrt(3) / 2},
+		{name: "two_sides", sides: 2, sideLength: 1, wantErr: true},
+		{name: "negative_sides", sides: -5, sideLength: 1, wantErr: true},
+		{name: "zero_length", sides: 5, sideLength: 0, wantErr: true},
+		{name: "nan_length", sides: 5, sideLength: math.NaN(), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := RegularPolygonArea(tt.sides, tt.sideLength)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("RegularPolygonArea(%d, %v) = %v, want error", tt.sides, tt.sideLength, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RegularPolygonArea(%d, %v) unexpected error: %v", tt.sides, tt.sideLength, err)
+			}
+			if math.Abs(result-tt.expected) > shapeEpsilon {
+				t.Errorf("RegularPolygonArea(%d, %v) = %v, want %v", tt.sides, tt.sideLength, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSphereVolume(t *testing.T) {
+	tests := []struct {
+		name     string
+		radius   float64
+		expected float64
+		wantErr  error
+	}{
+		{name: "unit", radius: 1, expected: 4.18879020478639},
+		{name: "radius_three", radius: 3, expected: 36 * math.Pi},
+		{name: "zero", radius: 0, wantErr: ErrNegative},
+		{name: "negative", radius: -1, wantErr: ErrNegative},
+		{name: "nan", radius: math.NaN(), wantErr: ErrNaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := SphereVolume(tt.radius)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("SphereVolume(%v) error = %v, want %v", tt.radius, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SphereVolume(%v) unexpected error: %v", tt.radius, err)
+			}
+			if math.Abs(result-tt.expected) > shapeEpsilon {
+				t.Errorf("SphereVolume(%v) = %v, want %v", tt.radius, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCylinderVolume(t *testing.T) {
+	tests := []struct {
+		name     string
+		radius   float64
+		height   float64
+		expected float64
+		wantErr  error
+	}{
+		{name: "basic", radius: 2, height: 5, expected: 20 * math.Pi},
+		{name: "unit", radius: 1, height: 1, expected: math.Pi},
+		{name: "negative_radius", radius: -2, height: 5, wantErr: ErrNegative},
+		{name: "zero_height", radius: 2, height: 0, wantErr: ErrNegative},
+		{name: "nan", radius: 2, height: math.NaN(), wantErr: ErrNaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CylinderVolume(tt.radius, tt.height)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("CylinderVolume(%v, %v) error = %v, want %v", tt.radius, tt.height, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CylinderVolume(%v, %v) unexpected error: %v", tt.radius, tt.height, err)
+			}
+			if math.Abs(result-tt.expected) > shapeEpsilon {
+				t.Errorf("CylinderVolume(%v, %v) = %v, want %v", tt.radius, tt.height, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBoxVolume(t *testing.T) {
+	tests := []struct {
+		name     string
+		l, w, h  float64
+		expected float64
+		wantErr  error
+	}{
+		{name: "basic", l: 2, w: 3, h: 4, expected: 24},
+		{name: "cube", l: 1.5, w: 1.5, h: 1.5, expected: 3.375},
+		{name: "negative_length", l: -2, w: 3, h: 4, wantErr: ErrNegative},
+		{name: "negative_width", l: 2, w: -3, h: 4, wantErr: ErrNegative},
+		{name: "zero_height", l: 2, w: 3, h: 0, wantErr: ErrNegative},
+		{name: "nan", l: 2, w: 3, h: math.NaN(), wantErr: ErrNaN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := BoxVolume(tt.l, tt.w, tt.h)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("BoxVolume(%v, %v, %v) error = %v, want %v", tt.l, tt.w, tt.h, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("BoxVolume(%v, %v, %v) unexpected error: %v", tt.l, tt.w, tt.h, err)
+			}
+			if math.Abs(result-tt.expected) > shapeEpsilon {
+				t.Errorf("BoxVolume(%v, %v, %v) = %v, want %v", tt.l, tt.w, tt.h, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Mean returns the arithmetic mean of values. Values whose sum exceeds the
+// float64 range still have a mean, since it lies between the smallest and
+// largest value.
+func Mean(values []float64) (float64, error) {
+	if err := checkSample(values); err != nil {
+		return 0, err
+	}
+
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+
+	n := float64(len(values))
+	if !math.IsInf(total, 0) {
+		return total / n, nil
+	}
+
+	// The sum overflowed; add the values scaled by 1/n instead, which keeps
+	// every partial sum within range
+	mean := 0.0
+	for _, v := range values {
+		mean += v / n
+	}
+	return mean, nil
+}
+
+// WeightedMean returns the mean of values with each value counted in
+// proportion to the matching weight, such as grades weighted by credits
+func WeightedMean(values, weights []float64) (float64, error) {
+	if len(values) != len(weights) {
+		return 0, fmt.Errorf("got %d values but %d weights", len(values), len(weights))
+	}
+
+	if err := checkSample(values); err != nil {
+		return 0, err
+	}
+
+	if err := checkSample(weights); err != nil {
+		return 0, fmt.Errorf("weights: %w", err)
+	}
+
+	total, weightSum := 0.0, 0.0
+	for i, v := range values {
+		// Check each product: opposite infinities would sum to NaN
+		product := v * weights[i]
+		if math.IsInf(product, 0) {
+			return 0, ErrOverflow
+		}
+		total += product
+		weightSum += weights[i]
+	}
+
+	if weightSum == 0 {
+		return 0, errors.New("weights sum to zero")
+	}
+
+	if math.IsInf(total, 0) || math.IsInf(weightSum, 0) {
+		return 0, ErrOverflow
+	}
+	return total / weightSum, nil
+}
+
+// Median returns the middle value of values, or the mean of the two middle
+// values when there is an even number of them. The caller's slice is left
+// unmodified.
+func Median(values []float64) (float64, error) {
+	if err := checkSample(values); err != nil {
+		return 0, err
+	}
+
+	sorted := sortedCopy(values)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid], nil
+	}
+	// Halve before adding so two huge middle values can't overflow
+	return sorted[mid-1]/2 + sorted[mid]/2, nil
+}
+
+// Percentile returns the pth percentile of values, for p from 0 to 100,
+// interpolating linearly between the two closest ranks. Percentile(values,
+// 50) equals Median(values). The caller's slice is left unmodified.
+func Percentile(values []float64, p float64) (float64, error) {
+	if err := checkSample(values); err != nil {
+		return 0, err
+	}
+
+	if !(p >= 0 && p <= 100) {
+		return 0, errors.New("percentile must be between 0 and 100")
+	}
+
+	sorted := sortedCopy(values)
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	if lower == len(sorted)-1 {
+		return sorted[lower], nil
+	}
+	// Weight both neighbours rather than take their difference, which could
+	// overflow for values of opposite sign near the float64 limits
+	frac := rank - float64(lower)
+	return sorted[lower]*(1-frac) + sorted[lower+1]*frac, nil
+}
+
+// Mode returns the most frequent values in ascending order. Every value
+// tied for the highest count is returned, so a sample with no repeats
+// yields all of its distinct values.
+func Mode(values []float64) ([]float64, error) {
+	if err := checkSample(values); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[float64]int, len(values))
+	highest := 0
+	for _, v := range values {
+		counts[v]++
+		highest = Max(highest, counts[v])
+	}
+
+	var modes []float64
+	for v, n := range counts {
+		if n == highest {
+			modes = append(modes, v)
+		}
+	}
+	sort.Float64s(modes)
+	return modes, nil
+}
+
+// Variance returns the variance of values. With sample set it divides by
+// n-1 to estimate the variance of the wider population the values were drawn
+// from, which needs at least two values; otherwise it divides by n.
+func Variance(values []float64, sample bool) (float64, error) {
+	mean, err := Mean(values)
+	if err != nil {
+		return 0, err
+	}
+
+	denominator := float64(len(values))
+	if sample {
+		if len(values) < 2 {
+			return 0, errors.New("sample variance needs at least 2 values")
+		}
+		denominator--
+	}
+
+	squares := 0.0
+	for _, v := range values {
+		d := v - mean
+		squares += d * d
+	}
+
+	if math.IsInf(squares, 0) {
+		return 0, ErrOverflow
+	}
+	return squares / denominator, nil
+}
+
+// StdDev returns the standard deviation of values, the square root of
+// Variance with the same sample or population denominator
+func StdDev(values []float64, sample bool) (float64, error) {
+	variance, err := Variance(values, sample)
+	if err != nil {
+		return 0, err
+	}
+	return math.Sqrt(variance), nil
+}
+
+// checkSample rejects empty samples and samples containing NaN or infinite
+// values
+func checkSample(values []float64) error {
+	if len(values) == 0 {
+		return errors.New("no values provided")
+	}
+
+	for _, v := range values {
+		if math.IsNaN(v) {
+			return ErrNaN
+		}
+
+		if math.IsInf(v, 0) {
+			return ErrInfinite
+		}
+	}
+	return nil
+}
+
+// sortedCopy returns an ascending copy of values
+func sortedCopy(values []float64) []float64 {
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+	return sorted
+}
@@ -0,0 +1,310 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestMean(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		expected float64
+		wantErr  bool
+	}{
+		{name: "single", values: []float64{7}, expected: 7},
+		{name: "integers", values: []float64{1, 2, 3, 4}, expected: 2.5},
+		{name: "mixed_signs", values: []float64{-5, 5, 10}, expected: 10.0 / 3},
+		{name: "empty", values: []float64{}, wantErr: true},
+		{name: "nil", values: nil, wantErr: true},
+		{name: "nan", values: []float64{1, math.NaN()}, wantErr: true},
+		{name: "huge_values", values: []float64{math.MaxFloat64, math.MaxFloat64}, expected: math.MaxFloat64},
+		{name: "sum_overflows", values: []float64{1e308, 1e308}, expected: 1e308},
+		{name: "partial_sum_overflows", values: []float64{1e308, 1e308, -1e308, -1e308}, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Mean(tt.values)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Mean(%v) = %v, want error", tt.values, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Mean(%v) unexpected error: %v", tt.values, err)
+			}
+			if math.Abs(result-tt.expected) > 1e-12 {
+				t.Errorf("Mean(%v) = %v, want %v", tt.values, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWeightedMean(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		weights  []float64
+		expected float64
+		wantErr  bool
+	}{
+		{name: "grades_by_credits", values: []float64{90, 80, 70}, weights: []float64{3, 2, 1}, expected: 250.0 / 3},
+		{name: "equal_weights", values: []float64{1, 2, 3, 4}, weights: []float64{1, 1, 1, 1}, expected: 2.5},
+		{name: "fractional_weights", values: []float64{10, 20}, weights: []float64{0.25, 0.75}, expected: 17.5},
+		{name: "zero_weight_ignored", values: []float64{5, 100}, weights: []float64{1, 0}, expected: 5},
+		{name: "length_mismatch", values: []float64{1, 2}, weights: []float64{1}, wantErr: true},
+		{name: "empty", values: []float64{}, weights: []float64{}, wantErr: true},
+		{name: "nil", values: nil, weights: nil, wantErr: true},
+		{name: "zero_weight_sum", values: []float64{1, 2}, weights: []float64{0, 0}, wantErr: true},
+		{name: "cancelling_weights", values: []float64{1, 2}, weights: []float64{1, -1}, wantErr: true},
+		{name: "nan_value", values: []float64{math.NaN()}, weights: []float64{1}, wantErr: true},
+		{name: "infinite_weight", values: []float64{1}, weights: []float64{math.Inf(1)}, wantErr: true},
+		{name: "overflow", values: []float64{math.MaxFloat64, math.MaxFloat64}, weights: []float64{2, 2}, wantErr: true},
+		{name: "opposite_overflows", values: []float64{1e308, -1e308}, weights: []float64{10, 10}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := WeightedMean(tt.values, tt.weights)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("WeightedMean(%v, %v) = %v, want error", tt.values, tt.weights, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("WeightedMean(%v, %v) unexpected error: %v", tt.values, tt.weights, err)
+			}
+			if math.Abs(result-tt.expected) > 1e-12 {
+				t.Errorf("WeightedMean(%v, %v) = %v, want %v", tt.values, tt.weights, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		expected float64
+		wantErr  bool
+	}{
+		{name: "single", values: []float64{4}, expected: 4},
+		{name: "odd_unsorted", values: []float64{9, 1, 5}, expected: 5},
+		{name: "even_unsorted", values: []float64{8, 2, 6, 4}, expected: 5},
+		{name: "even_fractional", values: []float64{1, 2}, expected: 1.5},
+		{name: "duplicates", values: []float64{3, 3, 1, 3}, expected: 3},
+		{name: "huge_middle", values: []float64{math.MaxFloat64, math.MaxFloat64}, expected: math.MaxFloat64},
+		{name: "empty", values: []float64{}, wantErr: true},
+		{name: "infinite", values: []float64{1, math.Inf(1)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Median(tt.values)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Median(%v) = %v, want error", tt.values, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Median(%v) unexpected error: %v", tt.values, err)
+			}
+			if result != tt.expected {
+				t.Errorf("Median(%v) = %v, want %v", tt.values, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMedianDoesNotMutateInput(t *testing.T) {
+	values := []float64{5, 3, 9, 1}
+	if _, err := Median(values); err != nil {
+		t.Fatalf("Median(%v) unexpected error: %v", values, err)
+	}
+	if want := []float64{5, 3, 9, 1}; !reflect.DeepEqual(values, want) {
+		t.Errorf("Median mutated its input: got %v, want %v", values, want)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	// quartiles 25.5, 40 and 42.5 by linear interpolation between ranks
+	data := []float64{43, 6, 39, 15, 47, 36, 41, 7, 49, 40, 42}
+
+	tests := []struct {
+		name     string
+		values   []float64
+		p        float64
+		expected float64
+		wantErr  bool
+	}{
+		{name: "minimum", values: data, p: 0, expected: 6},
+		{name: "first_quartile", values: data, p: 25, expected: 25.5},
+		{name: "median", values: data, p: 50, expected: 40},
+		{name: "third_quartile", values: data, p: 75, expected: 42.5},
+		{name: "maximum", values: data, p: 100, expected: 49},
+		{name: "interpolated", values: []float64{10, 20}, p: 90, expected: 19},
+		{name: "single", values: []float64{3}, p: 40, expected: 3},
+		{name: "huge_opposite_signs", values: []float64{-math.MaxFloat64, math.MaxFloat64}, p: 50, expected: 0},
+		{name: "empty", values: []float64{}, p: 50, wantErr: true},
+		{name: "nan_value", values: []float64{1, math.NaN()}, p: 50, wantErr: true},
+		{name: "below_range", values: data, p: -1, wantErr: true},
+		{name: "above_range", values: data, p: 100.5, wantErr: true},
+		{name: "nan_percentile", values: data, p: math.NaN(), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Percentile(tt.values, tt.p)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Percentile(%v, %v) = %v, want error", tt.values, tt.p, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Percentile(%v, %v) unexpected error: %v", tt.values, tt.p, err)
+			}
+			if math.Abs(result-tt.expected) > 1e-12 {
+				t.Errorf("Percentile(%v, %v) = %v, want %v", tt.values, tt.p, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPercentileMatchesMedian(t *testing.T) {
+	samples := [][]float64{
+		{5},
+		{8, 2, 6, 4},
+		{9, 1, 5},
+		{1.5, -3, 2.25, 7, 0.1, 4},
+	}
+
+	for _, values := range samples {
+		median, err := Median(values)
+		if err != nil {
+			t.Fatalf("Median(%v) unexpected error: %v", values, err)
+		}
+		percentile, err := Percentile(values, 50)
+		if err != nil {
+			t.Fatalf("Percentile(%v, 50) unexpected error: %v", values, err)
+		}
+		if percentile != median {
+			t.Errorf("Percentile(%v, 50) = %v, want Median %v", values, percentile, median)
+		}
+	}
+}
+
+func TestPercentileDoesNotMutateInput(t *testing.T) {
+	values := []float64{5, 3, 9, 1}
+	if _, err := Percentile(values, 25); err != nil {
+		t.Fatalf("Percentile(%v, 25) unexpected error: %v", values, err)
+	}
+	if want := []float64{5, 3, 9, 1}; !reflect.DeepEqual(values, want) {
+		t.Errorf("Percentile mutated its input: got %v, want %v", values, want)
+	}
+}
+
+func TestMode(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		expected []float64
+		wantErr  bool
+	}{
+		{name: "single_mode", values: []float64{1, 2, 2, 3}, expected: []float64{2}},
+		{name: "tied_modes", values: []float64{4, 1, 4, 1, 2}, expected: []float64{1, 4}},
+		{name: "all_distinct", values: []float64{3, 1, 2}, expected: []float64{1, 2, 3}},
+		{name: "single_value", values: []float64{-2.5}, expected: []float64{-2.5}},
+		{name: "empty", values: []float64{}, wantErr: true},
+		{name: "nan", values: []float64{math.NaN()}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Mode(tt.values)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Mode(%v) = %v, want error", tt.values, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Mode(%v) unexpected error: %v", tt.values, err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Mode(%v) = %v, want %v", tt.values, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStatsSentinelErrors(t *testing.T) {
+	if _, err := Mean([]float64{math.NaN()}); !errors.Is(err, ErrNaN) {
+		t.Errorf("Mean(NaN) error = %v, want %v", err, ErrNaN)
+	}
+	if _, err := Median([]float64{math.Inf(-1)}); !errors.Is(err, ErrInfinite) {
+		t.Errorf("Median(-Inf) error = %v, want %v", err, ErrInfinite)
+	}
+	if _, err := Variance([]float64{math.MaxFloat64, -math.MaxFloat64}, false); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Variance(MaxFloat64, -MaxFloat64) error = %v, want %v", err, ErrOverflow)
+	}
+}
+
+func TestVarianceAndStdDev(t *testing.T) {
+	// mean 5, squared deviations sum to 32
+	data := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+	tests := []struct {
+		name         string
+		values       []float64
+		sample       bool
+		wantVariance float64
+		wantStdDev   float64
+		wantErr      bool
+	}{
+		{name: "population", values: data, wantVariance: 4, wantStdDev: 2},
+		{name: "sample", values: data, sample: true, wantVariance: 32.0 / 7, wantStdDev: math.Sqrt(32.0 / 7)},
+		{name: "constant", values: []float64{3, 3, 3}, sample: true, wantVariance: 0, wantStdDev: 0},
+		{name: "population_single", values: []float64{42}, wantVariance: 0, wantStdDev: 0},
+		{name: "sample_single", values: []float64{42}, sample: true, wantErr: true},
+		{name: "empty", values: []float64{}, wantErr: true},
+		{name: "nan", values: []float64{1, math.NaN()}, wantErr: true},
+		{name: "huge_constant", values: []float64{1e308, 1e308}, sample: true, wantVariance: 0, wantStdDev: 0},
+		{name: "overflow", values: []float64{-1e300, 1e300}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			variance, err := Variance(tt.values, tt.sample)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Variance(%v, %v) = %v, want error", tt.values, tt.sample, variance)
+				}
+				if _, err := StdDev(tt.values, tt.sample); err == nil {
+					t.Errorf("StdDev(%v, %v) expected error", tt.values, tt.sample)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Variance(%v, %v) unexpected error: %v", tt.values, tt.sample, err)
+			}
+			if math.Abs(variance-tt.wantVariance) > 1e-12 {
+				t.Errorf("Variance(%v, %v) = %v, want %v", tt.values, tt.sample, variance, tt.wantVariance)
+			}
+
+			stddev, err := StdDev(tt.values, tt.sample)
+			if err != nil {
+				t.Fatalf("StdDev(%v, %v) unexpected error: %v", tt.values, tt.sample, err)
+			}
+			if math.Abs(stddev-tt.wantStdDev) > 1e-12 {
+				t.Errorf("StdDev(%v, %v) = %v, want %v", tt.values, tt.sample, stddev, tt.wantStdDev)
+			}
+		})
+	}
+}
@@ -0,0 +1,607 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Errors returned by ValidateEmailDetailed
+var (
+	ErrEmailEmpty         = errors.New("empty email")
+	ErrEmailTooShort      = errors.New("too short")
+	ErrEmailMissingAt     = errors.New("missing @")
+	ErrEmailMultipleAt    = errors.New("multiple @")
+	ErrEmailInvalidLocal  = errors.New("invalid local part")
+	ErrEmailInvalidDomain = errors.New("invalid domain")
+)
+
+var (
+	emailLocalRegex  = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+$`)
+	emailDomainRegex = regexp.MustCompile(`^[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+)
+
+// ValidateEmailDetailed validates an email address format, returning nil for
+// a valid address or an error describing why it is invalid
+func ValidateEmailDetailed(email string) error {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return ErrEmailEmpty
+	}
+
+	if len(email) < 5 { // Minimum: a@b.c
+		return ErrEmailTooShort
+	}
+
+	local, domain, found := strings.Cut(email, "@")
+	if !found {
+		return ErrEmailMissingAt
+	}
+
+	if strings.Contains(domain, "@") {
+		return ErrEmailMultipleAt
+	}
+
+	if !emailLocalRegex.MatchString(local) {
+		return ErrEmailInvalidLocal
+	}
+
+	if !emailDomainRegex.MatchString(domain) {
+		return ErrEmailInvalidDomain
+	}
+	return nil
+}
+
+// ValidateEmails validates each address with ValidateEmail, keyed by the
+// address as given. Duplicate addresses collapse into a single entry.
+func ValidateEmails(emails []string) map[string]bool {
+	results := make(map[string]bool, len(emails))
+	for _, email := range emails {
+		results[email] = ValidateEmail(email)
+	}
+	return results
+}
+
+// FilterValidEmails returns the addresses that pass ValidateEmail, unchanged
+// and in their original order. Duplicates are kept.
+func FilterValidEmails(emails []string) []string {
+	valid := make([]string, 0, len(emails))
+	for _, email := range emails {
+		if ValidateEmail(email) {
+			valid = append(valid, email)
+		}
+	}
+	return valid
+}
+
+// gmailDomains are the domains for which dots and plus-tags in the local
+// part do not change the mailbox
+var gmailDomains = map[string]bool{
+	"gmail.com":      true,
+	"googlemail.com": true,
+}
+
+// NormalizeEmail trims surrounding whitespace and lowercases the domain,
+// leaving the case-sensitive local part untouched. It returns the error from
+// ValidateEmailDetailed if the result is not a valid address.
+func NormalizeEmail(email string) (string, error) {
+	return NormalizeEmailWithAliases(email, false)
+}
+
+// NormalizeEmailWithAliases behaves like NormalizeEmail and, when
+// stripGmailAliases is true, also reduces Gmail addresses to their canonical
+// mailbox by removing dots and any "+tag" suffix from the local part and
+// lowercasing it, since Gmail ignores all three.
+func NormalizeEmailWithAliases(email string, stripGmailAliases bool) (string, error) {
+	email = strings.TrimSpace(email)
+	if err := ValidateEmailDetailed(email); err != nil {
+		return "", err
+	}
+
+	local, domain, _ := strings.Cut(email, "@")
+	domain = strings.ToLower(domain)
+
+	if stripGmailAliases && gmailDomains[domain] {
+		local, _, _ = strings.Cut(local, "+")
+		local = strings.ToLower(strings.ReplaceAll(local, ".", ""))
+	}
+
+	normalized := local + "@" + domain
+	if err := ValidateEmailDetailed(normalized); err != nil {
+		return "", err
+	}
+	return normalized, nil
+}
+
+// defaultDisposableDomains is the bundled list of throwaway email providers
+var defaultDisposableDomains = []string{
+	"10minutemail.com",
+	"20minutemail.com",
+	"discard.email",
+	"dispostable.com",
+	"fakeinbox.com",
+	"getnada.com",
+	"guerrillamail.com",
+	"maildrop.cc",
+	"mailinator.com",
+	"mailnesia.com",
+	"mintemail.com",
+	"mohmal.com",
+	"sharklasers.com",
+	"spamgourmet.com",
+	"temp-mail.org",
+	"tempmail.com",
+	"throwawaymail.com",
+	"trashmail.com",
+	"yopmail.com",
+}
+
+var (
+	disposableMu      sync.RWMutex
+	disposableDomains = domainSet(defaultDisposableDomains)
+)
+
+// DefaultDisposableDomains returns a copy of the bundled disposable domain
+// list, handy for extending it with SetDisposableDomains
+func DefaultDisposableDomains() []string {
+	return append([]string(nil), defaultDisposableDomains...)
+}
+
+// SetDisposableDomains replaces the list of domains IsDisposableEmail treats
+// as disposable. To extend rather than replace the bundled list, pass
+// append(DefaultDisposableDomains(), extra...).
+func SetDisposableDomains(domains []string) {
+	set := domainSet(domains)
+
+	disposableMu.Lock()
+	defer disposableMu.Unlock()
+	disposableDomains = set
+}
+
+// IsDisposableEmail reports whether the address belongs to a known disposable
+// email provider, including subdomains of one. The domain comparison is
+// case-insensitive.
+func IsDisposableEmail(email string) bool {
+	_, domain, found := strings.Cut(strings.TrimSpace(email), "@")
+	if !found || domain == "" {
+		return false
+	}
+	domain = strings.ToLower(domain)
+
+	disposableMu.RLock()
+	defer disposableMu.RUnlock()
+
+	for {
+		if disposableDomains[domain] {
+			return true
+		}
+		_, parent, ok := strings.Cut(domain, ".")
+		if !ok || !strings.Contains(parent, ".") {
+			return false
+		}
+		domain = parent
+	}
+}
+
+// domainSet builds a lowercase lookup set from a list of domains
+func domainSet(domains []string) map[string]bool {
+	set := make(map[string]bool, len(domains))
+	for _, d := range domains {
+		if d = strings.ToLower(strings.TrimSpace(d)); d != "" {
+			set[d] = true
+		}
+	}
+	return set
+}
+
+// emailLocalSpecials are the non-alphanumeric characters RFC 5322 permits in
+// an unquoted local part
+const emailLocalSpecials = "!#$%&'*+-/=?^_`{|}~."
+
+// ValidateEmailUnicode validates an email address that may contain non-ASCII
+// characters, following a relaxed reading of RFC 6531. The local part may use
+// any Unicode letters, digits and the RFC 5322 specials, and the domain may be
+// an internationalized domain name whose TLD is at least two letters.
+func ValidateEmailUnicode(email string) bool {
+	email = strings.TrimSpace(email)
+	if !utf8.ValidString(email) || len(email) > 254 {
+		return false
+	}
+
+	local, domain, found := strings.Cut(email, "@")
+	if !found || strings.Contains(domain, "@") {
+		return false
+	}
+	return validUnicodeLocal(local) && validUnicodeDomain(domain)
+}
+
+// validUnicodeLocal reports whether local is an acceptable unquoted local part
+func validUnicodeLocal(local string) bool {
+	if local == "" || len(local) > 64 {
+		return false
+	}
+
+	if strings.HasPrefix(local, ".") || strings.HasSuffix(local, ".") || strings.Contains(local, "..") {
+		return false
+	}
+
+	for _, r := range local {
+		if r < utf8.RuneSelf && !isASCIIAlnum(r) && !strings.ContainsRune(emailLocalSpecials, r) {
+			return false
+		}
+		if r >= utf8.RuneSelf && !unicode.In(r, unicode.Letter, unicode.Digit, unicode.Mark) {
+			return false
+		}
+	}
+	return true
+}
+
+// validUnicodeDomain reports whether domain is a plausible (possibly
+// internationalized) host name with a TLD of two or more letters
+func validUnicodeDomain(domain string) bool {
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return false
+	}
+
+	for _, label := range labels {
+		if label == "" || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return false
+		}
+		for _, r := range label {
+			if r != '-' && !unicode.In(r, unicode.Letter, unicode.Digit, unicode.Mark) {
+				return false
+			}
+		}
+	}
+
+	// The TLD is letters only, or a punycode-encoded IDN TLD such as xn--p1ai
+	tld := labels[len(labels)-1]
+	if strings.HasPrefix(strings.ToLower(tld), "xn--") {
+		return len(tld) > 4
+	}
+
+	if utf8.RuneCountInString(tld) < 2 {
+		return false
+	}
+	for _, r := range tld {
+		if !unicode.In(r, unicode.Letter, unicode.Mark) {
+			return false
+		}
+	}
+	return true
+}
+
+// isASCIIAlnum reports whether r is an ASCII letter or digit
+func isASCIIAlnum(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
+
+// phoneRegion describes how numbers are written in one region: the E.164
+// country calling code, the trunk prefix dialled before national numbers,
+// and the shape of the national significant number
+type phoneRegion struct {
+	countryCode string
+	trunkPrefix string
+	national    *regexp.Regexp
+}
+
+// phoneRegions are the regions ValidatePhone understands, keyed by ISO 3166
+// country code
+var phoneRegions = map[string]phoneRegion{
+	"US": {countryCode: "1", trunkPrefix: "1", national: regexp.MustCompile(`^[2-9]\d{2}[2-9]\d{6}$`)},
+	"CA": {countryCode: "1", trunkPrefix: "1", national: regexp.MustCompile(`^[2-9]\d{2}[2-9]\d{6}$`)},
+	"GB": {countryCode: "44", trunkPrefix: "0", national: regexp.MustCompile(`^[1-9]\d{8,9}$`)},
+	"DE": {countryCode: "49", trunkPrefix: "0", national: regexp.MustCompile(`^[1-9]\d{6,10}$`)},
+	"FR": {countryCode: "33", trunkPrefix: "0", national: regexp.MustCompile(`^[1-9]\d{8}$`)},
+	"IN": {countryCode: "91", trunkPrefix: "0", national: regexp.MustCompile(`^[1-9]\d{9}$`)},
+}
+
+// phoneSeparators are the characters people use to group phone digits
+var phoneSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+
+// ValidatePhone reports whether number is a valid phone number for region
+// (US, CA, GB, DE, FR or IN, in any case) and returns it in E.164 form, such
+// as "+14155552671". The number may be written nationally, with or without
+// the trunk prefix, or internationally with a leading "+" and the region's
+// country code; spaces, dashes, dots and parentheses are ignored. Only the
+// length and leading digits are checked, not whether the number is assigned.
+func ValidatePhone(number string, region string) (bool, string) {
+	info, ok := phoneRegions[strings.ToUpper(strings.TrimSpace(region))]
+	if !ok {
+		return false, ""
+	}
+
+	digits := phoneSeparators.Replace(strings.TrimSpace(number))
+	international := strings.HasPrefix(digits, "+")
+	digits = strings.TrimPrefix(digits, "+")
+	if digits == "" || strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' }) >= 0 {
+		return false, ""
+	}
+
+	if international {
+		national, found := strings.CutPrefix(digits, info.countryCode)
+		if !found {
+			return false, ""
+		}
+		digits = national
+	} else if trimmed, found := strings.CutPrefix(digits, info.trunkPrefix); found && info.national.MatchString(trimmed) {
+		digits = trimmed
+	}
+
+	if !info.national.MatchString(digits) {
+		return false, ""
+	}
+	return true, "+" + info.countryCode + digits
+}
+
+// Errors returned by ValidateURL and ValidateURLReachable, some wrapped with
+// the offending detail
+var (
+	ErrURLMissingScheme     = errors.New("missing scheme")
+	ErrURLUnsupportedScheme = errors.New("unsupported scheme")
+	ErrURLMissingHost       = errors.New("missing host")
+	ErrURLUnreachable       = errors.New("unreachable")
+)
+
+// ValidateURL reports whether raw is an absolute http or https URL with a
+// host, such as "https://example.com/path". Any other scheme, including
+// "javascript:" and "mailto:", is rejected. The error describes why an
+// invalid URL was rejected. No network access is made; see
+// ValidateURLReachable.
+func ValidateURL(raw string) (bool, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return false, fmt.Errorf("invalid URL: %w", err)
+	}
+
+	if u.Scheme == "" {
+		return false, ErrURLMissingScheme
+	}
+
+	if scheme := strings.ToLower(u.Scheme); scheme != "http" && scheme != "https" {
+		return false, fmt.Errorf("%w %q", ErrURLUnsupportedScheme, u.Scheme)
+	}
+
+	if u.Hostname() == "" {
+		return false, ErrURLMissingHost
+	}
+	return true, nil
+}
+
+// ValidateURLReachable behaves like ValidateURL and, for a well-formed URL,
+// also sends a HEAD request with client, which defaults to
+// http.DefaultClient when nil. The URL counts as reachable when the server
+// answers with a status below 400.
+func ValidateURLReachable(ctx context.Context, raw string, client *http.Client) (bool, error) {
+	if ok, err := ValidateURL(raw); !ok {
+		return false, err
+	}
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, strings.TrimSpace(raw), nil)
+	if err != nil {
+		return false, fmt.Errorf("invalid URL: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", ErrURLUnreachable, err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return false, fmt.Errorf("%w: %s", ErrURLUnreachable, resp.Status)
+	}
+	return true, nil
+}
+
+// cardSeparators are the characters people use to group card digits
+var cardSeparators = strings.NewReplacer(" ", "", "-", "")
+
+// ValidateCreditCard reports whether number, ignoring spaces and dashes, is
+// 12 to 19 digits long and passes the Luhn checksum. It also returns the
+// brand implied by the number's prefix and length: "Visa", "Mastercard",
+// "Amex", or "" when the number matches none of them. The brand is reported
+// even when the checksum fails.
+func ValidateCreditCard(number string) (bool, string) {
+	digits := cardSeparators.Replace(strings.TrimSpace(number))
+	if digits == "" || strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' }) >= 0 {
+		return false, ""
+	}
+
+	brand := cardBrand(digits)
+	if len(digits) < 12 || len(digits) > 19 {
+		return false, brand
+	}
+	return luhnValid(digits), brand
+}
+
+// cardBrand identifies the card network from the leading digits and length
+// of an all-digit card number
+func cardBrand(digits string) string {
+	switch n := len(digits); {
+	case digits[0] == '4' && (n == 13 || n == 16 || n == 19):
+		return "Visa"
+	case n == 15 && (strings.HasPrefix(digits, "34") || strings.HasPrefix(digits, "37")):
+		return "Amex"
+	case n == 16:
+		// Mastercard issues 51-55 and 2221-2720
+		prefix, _ := strconv.Atoi(digits[:4])
+		if (prefix >= 5100 && prefix <= 5599) || (prefix >= 2221 && prefix <= 2720) {
+			return "Mastercard"
+		}
+	}
+	return ""
+}
+
+// luhnValid reports whether an all-digit string passes the Luhn checksum
+func luhnValid(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// Errors returned by ValidateIBAN, some wrapped with the offending detail
+var (
+	ErrIBANInvalidCharacters = errors.New("invalid characters")
+	ErrIBANUnknownCountry    = errors.New("unknown country code")
+	ErrIBANInvalidLength     = errors.New("invalid length")
+	ErrIBANInvalidChecksum   = errors.New("invalid checksum")
+)
+
+// ibanLengths is the full IBAN length for each country, from the SWIFT IBAN
+// registry
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16,
+	"BG": 22, "BH": 22, "BR": 29, "CH": 21, "CR": 22, "CY": 28, "CZ": 24,
+	"DE": 22, "DK": 18, "DO": 28, "EE": 20, "EG": 29, "ES": 24, "FI": 18,
+	"FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27,
+	"GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26,
+	"IT": 27, "JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LI": 21, "LT": 20,
+	"LU": 20, "LV": 21, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MR": 27,
+	"MT": 31, "MU": 30, "NL": 18, "NO": 15, "PK": 24, "PL": 28, "PS": 29,
+	"PT": 25, "QA": 29, "RO": 24, "RS": 22, "SA": 24, "SE": 24, "SI": 19,
+	"SK": 24, "SM": 27, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24,
+	"XK": 20,
+}
+
+// ValidateIBAN reports whether iban is a valid International Bank Account
+// Number, ignoring spaces and letter case. It checks the characters, the
+// length registered for the country code, and the ISO 7064 mod-97
+// checksum. The error describes why an invalid IBAN was rejected.
+func ValidateIBAN(iban string) (bool, error) {
+	normalized := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(iban), " ", ""))
+	if len(normalized) < 4 || strings.IndexFunc(normalized, func(r rune) bool { return !isASCIIAlnum(r) }) >= 0 {
+		return false, ErrIBANInvalidCharacters
+	}
+
+	country := normalized[:2]
+	want, ok := ibanLengths[country]
+	if !ok {
+		return false, fmt.Errorf("%w %q", ErrIBANUnknownCountry, country)
+	}
+
+	if len(normalized) != want {
+		return false, fmt.Errorf("%w: %s IBANs have %d characters, got %d", ErrIBANInvalidLength, country, want, len(normalized))
+	}
+
+	if normalized[2] < '0' || normalized[2] > '9' || normalized[3] < '0' || normalized[3] > '9' {
+		return false, fmt.Errorf("%w: check digits must be numeric", ErrIBANInvalidCharacters)
+	}
+
+	// Move the country code and check digits to the end, read letters as
+	// 10-35, and reduce digit by digit to keep the remainder small
+	remainder := 0
+	for _, r := range normalized[4:] + normalized[:4] {
+		if r >= 'A' {
+			remainder = (remainder*100 + int(r-'A') + 10) % 97
+		} else {
+			remainder = (remainder*10 + int(r-'0')) % 97
+		}
+	}
+
+	if remainder != 1 {
+		return false, ErrIBANInvalidChecksum
+	}
+	return true, nil
+}
+
+// commonPasswords are widely used passwords that PasswordStrength always
+// scores 0, compared case-insensitively
+var commonPasswords = map[string]bool{
+	"123456": true, "12345678": true, "123456789": true, "1234567890": true,
+	"password": true, "password1": true, "password123": true, "passw0rd": true,
+	"qwerty": true, "qwerty123": true, "qwertyuiop": true, "abc123": true,
+	"111111": true, "letmein": true, "welcome": true, "admin": true,
+	"iloveyou": true, "monkey": true, "dragon": true, "sunshine": true,
+	"football": true, "baseball": true, "trustno1": true, "changeme": true,
+}
+
+// PasswordStrength scores pw from 0 (very weak) to 4 (strong). A point each
+// is given for reaching 8 and 12 characters, for using three of the four
+// character classes (lowercase, uppercase, digits, symbols) and for using
+// all four. Passwords under 8 characters score at most 1, and common
+// passwords score 0. Feedback lists what would improve the score and is
+// empty for a strong password.
+func PasswordStrength(pw string) (score int, feedback []string) {
+	if commonPasswords[strings.ToLower(pw)] {
+		return 0, []string{"Avoid common passwords; choose something harder to guess"}
+	}
+
+	length := utf8.RuneCountInString(pw)
+	switch {
+	case length < 8:
+		feedback = append(feedback, "Use at least 8 characters")
+	case length < 12:
+		score++
+		feedback = append(feedback, "Use 12 or more characters")
+	default:
+		score += 2
+	}
+
+	var lower, upper, digit, symbol bool
+	for _, r := range pw {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	classes := 0
+	for _, class := range []struct {
+		present bool
+		advice  string
+	}{
+		{lower, "Add lowercase letters"},
+		{upper, "Add uppercase letters"},
+		{digit, "Add digits"},
+		{symbol, "Add symbols such as ! or #"},
+	} {
+		if class.present {
+			classes++
+		} else {
+			feedback = append(feedback, class.advice)
+		}
+	}
+
+	if classes >= 3 {
+		score++
+	}
+	if classes == 4 {
+		score++
+	}
+
+	if length < 8 {
+		score = MinOf(score, 1)
+	}
+	return score, feedback
+}
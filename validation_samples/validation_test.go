@@ -0,0 +1,505 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestValidateEmailDetailed(t *testing.T) {
+	tests := []struct {
+		name  string
+		email string
+		want  error
+	}{
+		{name: "valid", email: "user@example.com", want: nil},
+		{name: "valid_plus_tag", email: "test.email+tag@example.co.uk", want: nil},
+		{name: "valid_padded", email: "  user@example.com  ", want: nil},
+		{name: "empty", email: "", want: ErrEmailEmpty},
+		{name: "whitespace_only", email: "   ", want: ErrEmailEmpty},
+		{name: "too_short", email: "a@b.", want: ErrEmailTooShort},
+		{name: "missing_at", email: "user.example.com", want: ErrEmailMissingAt},
+		{name: "multiple_at", email: "user@host@example.com", want: ErrEmailMultipleAt},
+		{name: "empty_local", email: "@example.com", want: ErrEmailInvalidLocal},
+		{name: "invalid_local_chars", email: "us er@example.com", want: ErrEmailInvalidLocal},
+		{name: "missing_tld", email: "user@example", want: ErrEmailInvalidDomain},
+		{name: "short_tld", email: "user@example.c", want: ErrEmailInvalidDomain},
+		{name: "numeric_tld", email: "user@example.123", want: ErrEmailInvalidDomain},
+		{name: "empty_domain", email: "user@", want: ErrEmailInvalidDomain},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateEmailDetailed(tt.email)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("ValidateEmailDetailed(%q) = %v, want %v", tt.email, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateEmail_DelegatesToDetailed(t *testing.T) {
+	emails := []string{"user@example.com", "", "a@b.", "user.example.com", "user@example", " padded@example.org "}
+
+	for _, email := range emails {
+		want := ValidateEmailDetailed(email) == nil
+		if got := ValidateEmail(email); got != want {
+			t.Errorf("ValidateEmail(%q) = %t, want %t", email, got, want)
+		}
+	}
+}
+
+func TestValidateEmailUnicode(t *testing.T) {
+	tests := []struct {
+		name     string
+		email    string
+		expected bool
+	}{
+		{name: "ascii", email: "user@example.com", expected: true},
+		{name: "plus_addressing", email: "user+tag@example.com", expected: true},
+		{name: "plus_only_tag", email: "user+@example.com", expected: true},
+		{name: "accented_local", email: "müller@example.com", expected: true},
+		{name: "accented_french", email: "josé.garcía@exemple.fr", expected: true},
+		{name: "cyrillic_local", email: "пользователь@example.com", expected: true},
+		{name: "cyrillic_idn_domain", email: "иван@пример.рф", expected: true},
+		{name: "punycode_tld", email: "ivan@xn--e1afmkfd.xn--p1ai", expected: true},
+		{name: "cjk_local", email: "用户@例子.广告", expected: true},
+		{name: "accented_domain", email: "info@bücher.de", expected: true},
+		{name: "padded", email: "  müller@example.com ", expected: true},
+		{name: "empty", email: "", expected: false},
+		{name: "missing_at", email: "müller.example.com", expected: false},
+		{name: "multiple_at", email: "a@b@example.com", expected: false},
+		{name: "empty_local", email: "@example.com", expected: false},
+		{name: "leading_dot", email: ".müller@example.com", expected: false},
+		{name: "consecutive_dots", email: "mül..ler@example.com", expected: false},
+		{name: "space_in_local", email: "mül ler@example.com", expected: false},
+		{name: "unicode_symbol_in_local", email: "user☃@example.com", expected: false},
+		{name: "missing_tld", email: "müller@example", expected: false},
+		{name: "short_tld", email: "müller@example.d", expected: false},
+		{name: "numeric_tld", email: "müller@example.123", expected: false},
+		{name: "hyphen_edge_label", email: "user@-example.com", expected: false},
+		{name: "empty_label", email: "user@example..com", expected: false},
+		{name: "invalid_utf8", email: "us\xffer@example.com", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidateEmailUnicode(tt.email); got != tt.expected {
+				t.Errorf("ValidateEmailUnicode(%q) = %t, want %t", tt.email, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateEmailUnicode_AcceptsASCIIValid(t *testing.T) {
+	for _, email := range []string{"user@example.com", "test.email+tag@example.co.uk", "a_b%c@sub.example.org"} {
+		if !ValidateEmail(email) || !ValidateEmailUnicode(email) {
+			t.Errorf("%q: ValidateEmail = %t, ValidateEmailUnicode = %t; want both true",
+				email, ValidateEmail(email), ValidateEmailUnicode(email))
+		}
+	}
+}
+
+func TestValidateEmails(t *testing.T) {
+	emails := []string{
+		"user@example.com",
+		"invalid-email",
+		"  padded@example.org  ",
+		"@example.com",
+		"user@example.com",
+		"",
+	}
+
+	results := ValidateEmails(emails)
+
+	want := map[string]bool{
+		"user@example.com":       true,
+		"invalid-email":          false,
+		"  padded@example.org  ": true,
+		"@example.com":           false,
+		"":                       false,
+	}
+	if len(results) != len(want) {
+		t.Fatalf("ValidateEmails() = %v, want %v", results, want)
+	}
+	for email, valid := range want {
+		if got, ok := results[email]; !ok || got != valid {
+			t.Errorf("ValidateEmails()[%q] = %t (present %t), want %t", email, got, ok, valid)
+		}
+	}
+}
+
+func TestValidateEmails_Empty(t *testing.T) {
+	if results := ValidateEmails(nil); results == nil || len(results) != 0 {
+		t.Errorf("ValidateEmails(nil) = %v, want empty map", results)
+	}
+}
+
+func TestFilterValidEmails(t *testing.T) {
+	emails := []string{
+		"b@example.com",
+		"not an email",
+		" a@example.com ",
+		"b@example.com",
+		"missing@tld",
+	}
+
+	got := FilterValidEmails(emails)
+
+	want := []string{"b@example.com", " a@example.com ", "b@example.com"}
+	if len(got) != len(want) {
+		t.Fatalf("FilterValidEmails() = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("FilterValidEmails()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestFilterValidEmails_NoneValid(t *testing.T) {
+	if got := FilterValidEmails([]string{"x", "y@z"}); got == nil || len(got) != 0 {
+		t.Errorf("FilterValidEmails() = %q, want empty slice", got)
+	}
+}
+
+func TestNormalizeEmail(t *testing.T) {
+	tests := []struct {
+		name     string
+		email    string
+		expected string
+		wantErr  error
+	}{
+		{name: "trim_and_lowercase_domain", email: "  User@EXAMPLE.COM ", expected: "User@example.com"},
+		{name: "already_normal", email: "user@example.com", expected: "user@example.com"},
+		{name: "local_case_preserved", email: "John.Doe+News@Example.Org", expected: "John.Doe+News@example.org"},
+		{name: "gmail_untouched_without_flag", email: "J.Doe+x@GMAIL.com", expected: "J.Doe+x@gmail.com"},
+		{name: "empty", email: "   ", wantErr: ErrEmailEmpty},
+		{name: "invalid", email: "not-an-email", wantErr: ErrEmailMissingAt},
+		{name: "bad_domain", email: "user@EXAMPLE", wantErr: ErrEmailInvalidDomain},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NormalizeEmail(tt.email)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("NormalizeEmail(%q) error = %v, want %v", tt.email, err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("NormalizeEmail(%q) unexpected error: %v", tt.email, err)
+			}
+			if result != tt.expected {
+				t.Errorf("NormalizeEmail(%q) = %q, want %q", tt.email, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNormalizeEmailWithAliases(t *testing.T) {
+	tests := []struct {
+		name     string
+		email    string
+		expected string
+		wantErr  bool
+	}{
+		{name: "gmail_dots_and_tag", email: " J.Doe+Newsletter@Gmail.com ", expected: "jdoe@gmail.com"},
+		{name: "googlemail", email: "j.d.o.e@googlemail.com", expected: "jdoe@googlemail.com"},
+		{name: "gmail_plain", email: "jdoe@gmail.com", expected: "jdoe@gmail.com"},
+		{name: "non_gmail_untouched", email: "J.Doe+tag@Example.com", expected: "J.Doe+tag@example.com"},
+		{name: "gmail_tag_only_local", email: "+tag@gmail.com", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NormalizeEmailWithAliases(tt.email, true)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("NormalizeEmailWithAliases(%q) = %q, want error", tt.email, result)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("NormalizeEmailWithAliases(%q) unexpected error: %v", tt.email, err)
+			}
+			if result != tt.expected {
+				t.Errorf("NormalizeEmailWithAliases(%q) = %q, want %q", tt.email, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNormalizeEmail_Deduplicates(t *testing.T) {
+	signups := []string{"Jane.Doe@gmail.com", " janedoe+promo@GMAIL.COM", "JANEDOE@gmail.com"}
+
+	seen := make(map[string]bool)
+	for _, email := range signups {
+		normalized, err := NormalizeEmailWithAliases(email, true)
+		if err != nil {
+			t.Fatalf("NormalizeEmailWithAliases(%q) unexpected error: %v", email, err)
+		}
+		seen[normalized] = true
+	}
+
+	if len(seen) != 1 {
+		t.Errorf("normalized signups = %v, want a single canonical address", seen)
+	}
+}
+
+func TestIsDisposableEmail(t *testing.T) {
+	tests := []struct {
+		name     string
+		email    string
+		expected bool
+	}{
+		{name: "mailinator", email: "someone@mailinator.com", expected: true},
+		{name: "ten_minute_mail", email: "x@10minutemail.com", expected: true},
+		{name: "case_insensitive_domain", email: "Someone@MAILINATOR.COM", expected: true},
+		{name: "subdomain", email: "user@inbox.mailinator.com", expected: true},
+		{name: "padded", email: "  user@yopmail.com ", expected: true},
+		{name: "normal_domain", email: "user@example.com", expected: false},
+		{name: "lookalike_domain", email: "user@notmailinator.com", expected: false},
+		{name: "disposable_name_as_local_part", email: "mailinator.com@example.com", expected: false},
+		{name: "missing_at", email: "mailinator.com", expected: false},
+		{name: "empty", email: "", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsDisposableEmail(tt.email); got != tt.expected {
+				t.Errorf("IsDisposableEmail(%q) = %t, want %t", tt.email, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetDisposableDomains(t *testing.T) {
+	defer SetDisposableDomains(DefaultDisposableDomains())
+
+	SetDisposableDomains([]string{"Throwaway.Example"})
+	if !IsDisposableEmail("user@throwaway.example") {
+		t.Errorf("IsDisposableEmail() = false for a domain added with SetDisposableDomains")
+	}
+	if IsDisposableEmail("user@mailinator.com") {
+		t.Errorf("IsDisposableEmail() = true for a bundled domain after the list was replaced")
+	}
+
+	SetDisposableDomains(append(DefaultDisposableDomains(), "throwaway.example"))
+	if !IsDisposableEmail("user@throwaway.example") || !IsDisposableEmail("user@mailinator.com") {
+		t.Errorf("extended list should contain both the bundled and the added domain")
+	}
+}
+
+func TestDefaultDisposableDomains_ReturnsCopy(t *testing.T) {
+	domains := DefaultDisposableDomains()
+	domains[0] = "changed.example"
+
+	if DefaultDisposableDomains()[0] == "changed.example" {
+		t.Errorf("modifying the returned slice changed the bundled list")
+	}
+}
+
+func TestValidatePhone(t *testing.T) {
+	tests := []struct {
+		name      string
+		number    string
+		region    string
+		wantValid bool
+		wantE164  string
+	}{
+		{name: "us_formatted", number: "(415) 555-2671", region: "US", wantValid: true, wantE164: "+14155552671"},
+		{name: "us_dotted", number: "415.555.2671", region: "US", wantValid: true, wantE164: "+14155552671"},
+		{name: "us_trunk_prefix", number: "1-415-555-2671", region: "US", wantValid: true, wantE164: "+14155552671"},
+		{name: "us_international", number: "+1 415 555 2671", region: "us", wantValid: true, wantE164: "+14155552671"},
+		{name: "ca", number: "604-555-0199", region: "CA", wantValid: true, wantE164: "+16045550199"},
+		{name: "gb_national", number: "020 7946 0958", region: "GB", wantValid: true, wantE164: "+442079460958"},
+		{name: "gb_international", number: "+44 20 7946 0958", region: "GB", wantValid: true, wantE164: "+442079460958"},
+		{name: "de", number: "030 123456", region: "DE", wantValid: true, wantE164: "+4930123456"},
+		{name: "fr", number: "01 23 45 67 89", region: "FR", wantValid: true, wantE164: "+33123456789"},
+		{name: "in", number: "098765 43210", region: "IN", wantValid: true, wantE164: "+919876543210"},
+		{name: "us_too_short", number: "555-2671", region: "US"},
+		{name: "us_too_long", number: "415-555-26711", region: "US"},
+		{name: "us_area_code_starts_with_one", number: "115-555-2671", region: "US"},
+		{name: "letters", number: "415-CALL-NOW", region: "US"},
+		{name: "wrong_country_code", number: "+44 20 7946 0958", region: "US"},
+		{name: "misplaced_plus", number: "415+5552671", region: "US"},
+		{name: "empty", number: "", region: "US"},
+		{name: "unknown_region", number: "415-555-2671", region: "ZZ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid, e164 := ValidatePhone(tt.number, tt.region)
+			if valid != tt.wantValid || e164 != tt.wantE164 {
+				t.Errorf("ValidatePhone(%q, %q) = %t, %q, want %t, %q", tt.number, tt.region, valid, e164, tt.wantValid, tt.wantE164)
+			}
+		})
+	}
+}
+
+func TestValidateURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		raw       string
+		wantValid bool
+		want      error
+	}{
+		{name: "https", raw: "https://example.com", wantValid: true},
+		{name: "https_path_query", raw: "https://example.com/a/b?q=1#frag", wantValid: true},
+		{name: "http_port", raw: "http://localhost:8080/health", wantValid: true},
+		{name: "uppercase_scheme", raw: "HTTPS://Example.com", wantValid: true},
+		{name: "padded", raw: "  https://example.com  ", wantValid: true},
+		{name: "missing_scheme", raw: "example.com/path", want: ErrURLMissingScheme},
+		{name: "empty", raw: "", want: ErrURLMissingScheme},
+		{name: "javascript", raw: "javascript:alert(1)", want: ErrURLUnsupportedScheme},
+		{name: "ftp", raw: "ftp://example.com/file", want: ErrURLUnsupportedScheme},
+		{name: "mailto", raw: "mailto:user@example.com", want: ErrURLUnsupportedScheme},
+		{name: "missing_host", raw: "https:///path", want: ErrURLMissingHost},
+		{name: "port_only", raw: "http://:8080", want: ErrURLMissingHost},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid, err := ValidateURL(tt.raw)
+			if valid != tt.wantValid || !errors.Is(err, tt.want) {
+				t.Errorf("ValidateURL(%q) = %t, %v, want %t, %v", tt.raw, valid, err, tt.wantValid, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateURL_ParseError(t *testing.T) {
+	if valid, err := ValidateURL("http://exa mple.com"); valid || err == nil {
+		t.Errorf("ValidateURL with a space in the host = %t, %v, want false and an error", valid, err)
+	}
+}
+
+func TestValidateURLReachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	if valid, err := ValidateURLReachable(ctx, server.URL+"/ok", server.Client()); !valid || err != nil {
+		t.Errorf("ValidateURLReachable(ok) = %t, %v, want true, nil", valid, err)
+	}
+	if valid, err := ValidateURLReachable(ctx, server.URL+"/missing", server.Client()); valid || !errors.Is(err, ErrURLUnreachable) {
+		t.Errorf("ValidateURLReachable(missing) = %t, %v, want false, %v", valid, err, ErrURLUnreachable)
+	}
+	if valid, err := ValidateURLReachable(ctx, "javascript:alert(1)", nil); valid || !errors.Is(err, ErrURLUnsupportedScheme) {
+		t.Errorf("ValidateURLReachable(javascript) = %t, %v, want false, %v", valid, err, ErrURLUnsupportedScheme)
+	}
+
+	url := server.URL
+	server.Close()
+	if valid, err := ValidateURLReachable(ctx, url, nil); valid || !errors.Is(err, ErrURLUnreachable) {
+		t.Errorf("ValidateURLReachable(closed server) = %t, %v, want false, %v", valid, err, ErrURLUnreachable)
+	}
+}
+
+func TestValidateCreditCard(t *testing.T) {
+	tests := []struct {
+		name      string
+		number    string
+		wantValid bool
+		wantBrand string
+	}{
+		{name: "visa", number: "4111111111111111", wantValid: true, wantBrand: "Visa"},
+		{name: "visa_spaced", number: "4111 1111 1111 1111", wantValid: true, wantBrand: "Visa"},
+		{name: "visa_13_digits", number: "4222222222222", wantValid: true, wantBrand: "Visa"},
+		{name: "mastercard", number: "5555-5555-5555-4444", wantValid: true, wantBrand: "Mastercard"},
+		{name: "mastercard_2_series", number: "2223003122003222", wantValid: true, wantBrand: "Mastercard"},
+		{name: "amex", number: "3782 822463 10005", wantValid: true, wantBrand: "Amex"},
+		{name: "unknown_brand", number: "6011111111111117", wantValid: true, wantBrand: ""},
+		{name: "bad_checksum", number: "4111111111111112", wantValid: false, wantBrand: "Visa"},
+		{name: "too_short", number: "42", wantValid: false, wantBrand: ""},
+		{name: "letters", number: "4111-1111-1111-111a", wantValid: false, wantBrand: ""},
+		{name: "empty", number: "", wantValid: false, wantBrand: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid, brand := ValidateCreditCard(tt.number)
+			if valid != tt.wantValid || brand != tt.wantBrand {
+				t.Errorf("ValidateCreditCard(%q) = %t, %q, want %t, %q", tt.number, valid, brand, tt.wantValid, tt.wantBrand)
+			}
+		})
+	}
+}
+
+func TestValidateIBAN(t *testing.T) {
+	tests := []struct {
+		name      string
+		iban      string
+		wantValid bool
+		want      error
+	}{
+		{name: "gb", iban: "GB82WEST12345698765432", wantValid: true},
+		{name: "gb_grouped_lowercase", iban: "gb82 west 1234 5698 7654 32", wantValid: true},
+		{name: "de", iban: "DE89 3704 0044 0532 0130 00", wantValid: true},
+		{name: "nl", iban: "NL91ABNA0417164300", wantValid: true},
+		{name: "no_shortest", iban: "NO9386011117947", wantValid: true},
+		{name: "broken_checksum", iban: "GB82WEST12345698765433", want: ErrIBANInvalidChecksum},
+		{name: "swapped_digits", iban: "DE89370400440532013001", want: ErrIBANInvalidChecksum},
+		{name: "wrong_length", iban: "GB82WEST1234569876543", want: ErrIBANInvalidLength},
+		{name: "unknown_country", iban: "ZZ82WEST12345698765432", want: ErrIBANUnknownCountry},
+		{name: "letter_check_digits", iban: "GBXXWEST12345698765432", want: ErrIBANInvalidCharacters},
+		{name: "punctuation", iban: "GB82-WEST-1234-5698-7654-32", want: ErrIBANInvalidCharacters},
+		{name: "too_short", iban: "GB8", want: ErrIBANInvalidCharacters},
+		{name: "empty", iban: "", want: ErrIBANInvalidCharacters},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid, err := ValidateIBAN(tt.iban)
+			if valid != tt.wantValid || !errors.Is(err, tt.want) {
+				t.Errorf("ValidateIBAN(%q) = %t, %v, want %t, %v", tt.iban, valid, err, tt.wantValid, tt.want)
+			}
+		})
+	}
+}
+
+func TestPasswordStrength(t *testing.T) {
+	tests := []struct {
+		name         string
+		pw           string
+		expected     int
+		wantFeedback []string
+	}{
+		{name: "empty", pw: "", expected: 0, wantFeedback: []string{"Use at least 8 characters", "Add lowercase letters", "Add uppercase letters", "Add digits", "Add symbols such as ! or #"}},
+		{name: "common", pw: "Password", expected: 0, wantFeedback: []string{"Avoid common passwords; choose something harder to guess"}},
+		{name: "short_lowercase", pw: "cat", expected: 0, wantFeedback: []string{"Use at least 8 characters", "Add uppercase letters", "Add digits", "Add symbols such as ! or #"}},
+		{name: "short_all_classes_capped", pw: "aB3$", expected: 1, wantFeedback: []string{"Use at least 8 characters"}},
+		{name: "eight_lowercase", pw: "horsecat", expected: 1, wantFeedback: []string{"Use 12 or more characters", "Add uppercase letters", "Add digits", "Add symbols such as ! or #"}},
+		{name: "long_lowercase", pw: "correcthorsebattery", expected: 2, wantFeedback: []string{"Add uppercase letters", "Add digits", "Add symbols such as ! or #"}},
+		{name: "three_classes", pw: "Horsecat7", expected: 2, wantFeedback: []string{"Use 12 or more characters", "Add symbols such as ! or #"}},
+		{name: "long_three_classes", pw: "CorrectHorse42", expected: 3, wantFeedback: []string{"Add symbols such as ! or #"}},
+		{name: "strong", pw: "Tr0ub4dor&3xtra!", expected: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, feedback := PasswordStrength(tt.pw)
+			if score != tt.expected {
+				t.Errorf("PasswordStrength(%q) score = %d, want %d", tt.pw, score, tt.expected)
+			}
+			if len(feedback) != 0 || len(tt.wantFeedback) != 0 {
+				if !reflect.DeepEqual(feedback, tt.wantFeedback) {
+					t.Errorf("PasswordStrength(%q) feedback = %q, want %q", tt.pw, feedback, tt.wantFeedback)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"math"
+	"strings"
+)
+
+var (
+	smallNumberWords = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+		"seventeen", "eighteen", "nineteen",
+	}
+	tensWords = []string{
+		"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety",
+	}
+	// scaleWords names each power of one thousand an int can reach
+	scaleWords = []string{
+		"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion",
+	}
+)
+
+// NumberToWords spells out n in English words, such as "one thousand two
+// hundred thirty-four" for 1234 or "negative seven" for -7
+func NumberToWords(n int) string {
+	if n == 0 {
+		return "zero"
+	}
+
+	// uint keeps the magnitude of math.MinInt representable
+	magnitude := uint(n)
+	if n < 0 {
+		magnitude = -magnitude
+	}
+
+	var groups []string
+	for scale := 0; magnitude > 0; scale++ {
+		if group := int(magnitude % 1000); group > 0 {
+			words := hundredsToWords(group)
+			if scaleWords[scale] != "" {
+				words += " " + scaleWords[scale]
+			}
+			groups = append([]string{words}, groups...)
+		}
+		magnitude /= 1000
+	}
+
+	words := strings.Join(groups, " ")
+	if n < 0 {
+		return "negative " + words
+	}
+	return words
+}
+
+// CurrencyToWords spells out a dollar amount for check printing, such as
+// "one thousand two hundred thirty-four dollars and fifty-six cents". The
+// amount is rounded to the nearest cent. NaN, infinite and out-of-range
+// amounts return "Invalid amount", like FormatCurrency.
+func CurrencyToWords(amount float64) string {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return "Invalid amount"
+	}
+
+	totalCents := math.Round(math.Abs(amount) * 100)
+	if totalCents >= math.MaxInt64 {
+		return "Invalid amount"
+	}
+
+	cents := int(totalCents)
+	words := pluralWords(cents/100, "dollar") + " and " + pluralWords(cents%100, "cent")
+	if cents > 0 && amount < 0 {
+		return "negative " + words
+	}
+	return words
+}
+
+// hundredsToWords spells out a number from 1 to 999
+func hundredsToWords(n int) string {
+	var parts []string
+	if n >= 100 {
+		parts = append(parts, smallNumberWords[n/100]+" hundred")
+		n %= 100
+	}
+
+	switch {
+	case n >= 20 && n%10 != 0:
+		parts = append(parts, tensWords[n/10]+"-"+smallNumberWords[n%10])
+	case n >= 20:
+		parts = append(parts, tensWords[n/10])
+	case n > 0:
+		parts = append(parts, smallNumberWords[n])
+	}
+	return strings.Join(parts, " ")
+}
+
+// pluralWords spells out n followed by unit, pluralized unless n is one
+func pluralWords(n int, unit string) string {
+	if n == 1 {
+		return "one " + unit
+	}
+	return NumberToWords(n) + " " + unit + "s"
+}
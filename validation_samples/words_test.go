@@ -0,0 +1,64 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNumberToWords(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected string
+	}{
+		{name: "zero", n: 0, expected: "zero"},
+		{name: "single_digit", n: 7, expected: "seven"},
+		{name: "teen", n: 13, expected: "thirteen"},
+		{name: "round_tens", n: 40, expected: "forty"},
+		{name: "hyphenated", n: 99, expected: "ninety-nine"},
+		{name: "hundred", n: 100, expected: "one hundred"},
+		{name: "hundreds_and_units", n: 305, expected: "three hundred five"},
+		{name: "example", n: 1234, expected: "one thousand two hundred thirty-four"},
+		{name: "skips_empty_group", n: 1000001, expected: "one million one"},
+		{name: "round_million", n: 5000000, expected: "five million"},
+		{name: "negative", n: -42, expected: "negative forty-two"},
+		{name: "large", n: 2_147_483_647, expected: "two billion one hundred forty-seven million four hundred eighty-three thousand six hundred forty-seven"},
+		{name: "min_int", n: math.MinInt, expected: "negative nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NumberToWords(tt.n); got != tt.expected {
+				t.Errorf("NumberToWords(%d) = %q, want %q", tt.n, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCurrencyToWords(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		expected string
+	}{
+		{name: "example", amount: 1234.56, expected: "one thousand two hundred thirty-four dollars and fifty-six cents"},
+		{name: "zero", amount: 0, expected: "zero dollars and zero cents"},
+		{name: "singular", amount: 1.01, expected: "one dollar and one cent"},
+		{name: "whole_dollars", amount: 20, expected: "twenty dollars and zero cents"},
+		{name: "cents_only", amount: 0.99, expected: "zero dollars and ninety-nine cents"},
+		{name: "rounds_to_cents", amount: 2.999, expected: "three dollars and zero cents"},
+		{name: "negative", amount: -15.5, expected: "negative fifteen dollars and fifty cents"},
+		{name: "tiny_negative", amount: -0.001, expected: "zero dollars and zero cents"},
+		{name: "nan", amount: math.NaN(), expected: "Invalid amount"},
+		{name: "infinite", amount: math.Inf(1), expected: "Invalid amount"},
+		{name: "out_of_range", amount: 1e20, expected: "Invalid amount"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CurrencyToWords(tt.amount); got != tt.expected {
+				t.Errorf("CurrencyToWords(%v) = %q, want %q", tt.amount, got, tt.expected)
+			}
+		})
+	}
+}